@@ -0,0 +1,165 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common"
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common/k8sclient"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+	"github.com/ory/viper"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var sonataflowBuildGVR = schema.GroupVersionResource{
+	Group:    "sonataflow.org",
+	Version:  "v1alpha08",
+	Resource: "sonataflowbuilds",
+}
+
+// builderPodSelectors match the builder pods of a workflow: the operator builder pods first, then the Tekton ones.
+var builderPodSelectors = []string{
+	"sonataflow.org/containerBuildContext=%s,sonataflow.org/component=builder",
+	"tekton.dev/pipelineRun,sonataflow.org/workflow-app=%s",
+}
+
+// LogsCmdConfig holds the configuration of the build logs command.
+type LogsCmdConfig struct {
+	Name      string
+	NameSpace string
+	Follow    bool
+}
+
+func NewLogsCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "logs [workflow name]",
+		Short: "Stream the builder logs of a SonataFlow project.",
+		Long: `
+	Streams the logs of the pod building the given workflow, or the workflow of the SonataFlow project in the
+	current directory.
+
+	When the builder pod is no longer available, the tail of the logs captured in the build status is printed.
+	`,
+		Example: `
+	# Stream the builder logs of the current project using the current namespace.
+	{{.Name}} build logs
+
+	# Stream the builder logs of a workflow using a custom namespace.
+	{{.Name}} build logs <workflow_name> --namespace <your_namespace>
+
+	# Print the builder logs without waiting for the build to complete.
+	{{.Name}} build logs --follow=false
+		`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: common.BindEnv("namespace", "follow"),
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runLogs(cmd, args)
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Target namespace of your deployment.")
+	cmd.Flags().BoolP("follow", "f", true, "Follow the logs while the build runs.")
+
+	cmd.SetHelpFunc(common.DefaultTemplatedHelp)
+
+	return cmd
+}
+
+func runLogs(cmd *cobra.Command, args []string) error {
+	cfg := LogsCmdConfig{NameSpace: viper.GetString("namespace"), Follow: viper.GetBool("follow")}
+	if len(cfg.NameSpace) == 0 {
+		if defaultNamespace, err := common.GetCurrentNamespace(); err == nil {
+			cfg.NameSpace = defaultNamespace
+		} else {
+			return err
+		}
+	}
+
+	if len(args) > 0 {
+		cfg.Name = args[0]
+	} else {
+		name, err := projectWorkflowName(cfg)
+		if err != nil {
+			return err
+		}
+		cfg.Name = name
+	}
+
+	build, err := getBuild(cfg)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("🔨 Build phase of the workflow %s: %s\n", cfg.Name, build.Status.BuildPhase)
+	return streamBuildLogs(cfg, build, cmd.OutOrStdout())
+}
+
+func projectWorkflowName(cfg LogsCmdConfig) (string, error) {
+	file, err := common.FindSonataFlowFile(common.WorkflowExtensionsType)
+	if err != nil {
+		return "", err
+	}
+	swfFile, err := common.MustGetFile(file)
+	if err != nil {
+		return "", err
+	}
+	project, err := workflowproj.New(cfg.NameSpace).WithWorkflow(swfFile).AsObjects()
+	if err != nil {
+		return "", err
+	}
+	return project.Workflow.Name, nil
+}
+
+func getBuild(cfg LogsCmdConfig) (*operatorapi.SonataFlowBuild, error) {
+	object, err := common.ExecuteGet(sonataflowBuildGVR, cfg.Name, cfg.NameSpace)
+	if err != nil {
+		return nil, fmt.Errorf("❌ ERROR: no build found for the workflow %s in namespace %s: %w", cfg.Name, cfg.NameSpace, err)
+	}
+	build := &operatorapi.SonataFlowBuild{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, build); err != nil {
+		return nil, fmt.Errorf("❌ ERROR: failed to read the build of the workflow %s: %w", cfg.Name, err)
+	}
+	return build, nil
+}
+
+// streamBuildLogs streams the logs of the builder pod, or prints the logs captured in the build status if the pod is gone.
+func streamBuildLogs(cfg LogsCmdConfig, build *operatorapi.SonataFlowBuild, out io.Writer) error {
+	for _, selector := range builderPodSelectors {
+		err := common.StreamPodLogs(cfg.NameSpace, fmt.Sprintf(selector, cfg.Name), cfg.Follow, out)
+		var noPodFound k8sclient.NoPodFoundError
+		if errors.As(err, &noPodFound) {
+			continue
+		}
+		return err
+	}
+
+	if len(build.Status.Logs) > 0 {
+		fmt.Fprintln(out, "ℹ️  The builder pod is no longer available, showing the logs captured in the build status:")
+		fmt.Fprintln(out, build.Status.Logs)
+		return nil
+	}
+	return fmt.Errorf("❌ ERROR: no builder pod found for the workflow %s in namespace %s", cfg.Name, cfg.NameSpace)
+}
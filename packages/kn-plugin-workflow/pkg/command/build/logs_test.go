@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common/k8sclient"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStreamBuildLogs(t *testing.T) {
+	builderPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sonataflow-greeting-builder",
+			Namespace: "default",
+			Labels: map[string]string{
+				"sonataflow.org/containerBuildContext": "greeting",
+				"sonataflow.org/component":             "builder",
+			},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "builder"}}},
+	}
+	cfg := LogsCmdConfig{Name: "greeting", NameSpace: "default"}
+	build := &operatorapi.SonataFlowBuild{Status: operatorapi.SonataFlowBuildStatus{Logs: "error: exit status 1"}}
+
+	originalKubeClient := k8sclient.KubeClient
+	defer func() {
+		k8sclient.KubeClient = originalKubeClient
+	}()
+	withPods := func(pods ...runtime.Object) {
+		k8sclient.KubeClient = func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(pods...), nil
+		}
+	}
+
+	t.Run("Builder pod logs", func(t *testing.T) {
+		withPods(builderPod)
+		out := &bytes.Buffer{}
+		assert.NoError(t, streamBuildLogs(cfg, build, out))
+		// the fake clientset always answers with the same logs
+		assert.Equal(t, "fake logs", out.String())
+	})
+
+	t.Run("Logs captured in the build status", func(t *testing.T) {
+		withPods()
+		out := &bytes.Buffer{}
+		assert.NoError(t, streamBuildLogs(cfg, build, out))
+		assert.Contains(t, out.String(), "error: exit status 1")
+	})
+
+	t.Run("No logs", func(t *testing.T) {
+		withPods()
+		assert.Error(t, streamBuildLogs(cfg, &operatorapi.SonataFlowBuild{}, &bytes.Buffer{}))
+	})
+}
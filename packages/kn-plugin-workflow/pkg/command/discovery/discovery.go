@@ -0,0 +1,46 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common"
+	"github.com/spf13/cobra"
+)
+
+func NewDiscoveryCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "discovery",
+		Short: "Inspect the service discovery references of a SonataFlow project.",
+		Long: `
+	Inspect how the service discovery references of a SonataFlow project, e.g. ${kubernetes:services.v1/my-service},
+	are resolved in the cluster.
+	`,
+		Example: `
+	# Explain how the service discovery references of the current project are resolved.
+	{{.Name}} discovery explain
+		`,
+	}
+
+	cmd.AddCommand(NewExplainCommand())
+
+	cmd.SetHelpFunc(common.DefaultTemplatedHelp)
+
+	return cmd
+}
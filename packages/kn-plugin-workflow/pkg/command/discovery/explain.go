@@ -0,0 +1,226 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common"
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj/discovery"
+	"github.com/magiconair/properties"
+	"github.com/ory/viper"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	resourceFound    = "found"
+	resourceNotFound = "not found"
+	resourceUnknown  = "unknown"
+	notDeployed      = "<workflow not deployed>"
+)
+
+var sonataflowGVR = schema.GroupVersionResource{
+	Group:    "sonataflow.org",
+	Version:  "v1alpha08",
+	Resource: "sonataflows",
+}
+
+// ExplainCmdConfig holds the configuration of the discovery explain command.
+type ExplainCmdConfig struct {
+	NameSpace string
+}
+
+// resolution is a row of the resolution table printed by the explain command.
+type resolution struct {
+	Source   string
+	Uri      string
+	Resource string
+	Result   string
+}
+
+func NewExplainCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "explain",
+		Short: "Explain how the service discovery references of a SonataFlow project are resolved.",
+		Long: `
+	Parses the application.properties and the function operations of the SonataFlow project in the current
+	directory, checks that every referenced resource exists in the cluster, and prints a resolution table.
+
+	The addresses, and the resolution errors, are read from the status of the deployed workflow.
+	`,
+		Example: `
+	# Explain the service discovery references using the current namespace.
+	{{.Name}} discovery explain
+
+	# Explain the service discovery references using a custom namespace.
+	{{.Name}} discovery explain --namespace <your_namespace>
+	# Shorthand for the previous example:
+	{{.Name}} discovery explain -n <your_namespace>
+		`,
+		PreRunE:    common.BindEnv("namespace"),
+		SuggestFor: []string{"explian", "explan"}, //nolint:misspell
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runExplain(cmd, args)
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Target namespace of your deployment.")
+
+	cmd.SetHelpFunc(common.DefaultTemplatedHelp)
+
+	return cmd
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	cfg := ExplainCmdConfig{NameSpace: viper.GetString("namespace")}
+	if len(cfg.NameSpace) == 0 {
+		if defaultNamespace, err := common.GetCurrentNamespace(); err == nil {
+			cfg.NameSpace = defaultNamespace
+		} else {
+			return err
+		}
+	}
+
+	project, err := loadProject(cfg)
+	if err != nil {
+		return err
+	}
+
+	props := properties.NewProperties()
+	if project.Properties != nil {
+		loader := &properties.Loader{Encoding: properties.UTF8, DisableExpansion: true}
+		if props, err = loader.LoadBytes([]byte(project.Properties.Data[workflowproj.ApplicationPropertiesFileName])); err != nil {
+			return fmt.Errorf("❌ ERROR: failed to parse %s: %w", metadata.ApplicationProperties, err)
+		}
+	}
+
+	references := discovery.FindReferences(props, project.Workflow.Spec.Flow.Functions)
+	if len(references) == 0 {
+		fmt.Println("ℹ️  No service discovery references were found in your project.")
+		return nil
+	}
+
+	deployed, err := deployedReferences(project.Workflow)
+	if err != nil {
+		return fmt.Errorf("❌ ERROR: failed to get the deployed workflow %s: %w", project.Workflow.Name, err)
+	}
+	resolutions := make([]resolution, 0, len(references))
+	for _, reference := range references {
+		resolutions = append(resolutions, resolve(reference, project.Workflow.Namespace, deployed))
+	}
+	printResolutions(resolutions)
+	return nil
+}
+
+func loadProject(cfg ExplainCmdConfig) (*workflowproj.WorkflowProject, error) {
+	file, err := common.FindSonataFlowFile(common.WorkflowExtensionsType)
+	if err != nil {
+		return nil, err
+	}
+	swfFile, err := common.MustGetFile(file)
+	if err != nil {
+		return nil, err
+	}
+	handler := workflowproj.New(cfg.NameSpace).WithWorkflow(swfFile)
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("❌ ERROR: failed to get current directory: %w", err)
+	}
+	propertiesPath := filepath.Join(dir, metadata.ApplicationProperties)
+	if fileInfo, err := os.Stat(propertiesPath); err == nil && !fileInfo.IsDir() {
+		appIO, err := common.MustGetFile(propertiesPath)
+		if err != nil {
+			return nil, err
+		}
+		handler.WithAppProperties(appIO)
+	}
+	return handler.AsObjects()
+}
+
+// deployedReferences returns the discovery references reported by the deployed workflow, keyed by source,
+// or nil if the workflow is not deployed.
+func deployedReferences(workflow *operatorapi.SonataFlow) (map[string]operatorapi.DiscoveryReferenceStatus, error) {
+	deployedWorkflow, err := common.ExecuteGet(sonataflowGVR, workflow.Name, workflow.Namespace)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	deployed := &operatorapi.SonataFlow{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(deployedWorkflow.Object, deployed); err != nil {
+		return nil, err
+	}
+	references := make(map[string]operatorapi.DiscoveryReferenceStatus, len(deployed.Status.DiscoveryReferences))
+	for _, reference := range deployed.Status.DiscoveryReferences {
+		references[reference.Source] = reference
+	}
+	return references, nil
+}
+
+func resolve(reference discovery.Reference, namespace string, deployed map[string]operatorapi.DiscoveryReferenceStatus) resolution {
+	result := resolution{Source: reference.Source, Uri: reference.Uri}
+	uri, err := discovery.ParseUri(reference.Uri)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	if len(uri.Namespace) == 0 {
+		uri.Namespace = namespace
+	}
+	gvr := schema.GroupVersionResource{Group: uri.GVK.Group, Version: uri.GVK.Version, Resource: uri.GVK.Kind}
+	// the RBAC and connectivity errors don't tell whether the resource exists
+	if _, err = common.ExecuteGet(gvr, uri.Name, uri.Namespace); errors.IsNotFound(err) {
+		result.Resource = resourceNotFound
+	} else if err != nil {
+		result.Resource = fmt.Sprintf("%s: %v", resourceUnknown, err)
+	} else {
+		result.Resource = resourceFound
+	}
+	if deployed == nil {
+		result.Result = notDeployed
+	} else if status, ok := deployed[reference.Source]; ok {
+		if len(status.Error) > 0 {
+			result.Result = status.Error
+		} else {
+			result.Result = status.Address
+		}
+	}
+	return result
+}
+
+func printResolutions(resolutions []resolution) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "SOURCE\tURI\tRESOURCE\tADDRESS/ERROR")
+	for _, r := range resolutions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Source, r.Uri, r.Resource, r.Result)
+	}
+	w.Flush()
+}
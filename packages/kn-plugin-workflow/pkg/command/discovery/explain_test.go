@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common/k8sclient"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj/discovery"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestResolve(t *testing.T) {
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("v1")
+	service.SetKind("Service")
+	service.SetName("my-service")
+	service.SetNamespace("default")
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), service)
+
+	originalDynamicClient := k8sclient.DynamicClient
+	defer func() {
+		k8sclient.DynamicClient = originalDynamicClient
+	}()
+	k8sclient.DynamicClient = func() (dynamic.Interface, error) {
+		return dynamicClient, nil
+	}
+
+	deployed := map[string]operatorapi.DiscoveryReferenceStatus{
+		"service1": {Source: "service1", Uri: "kubernetes:services.v1/my-service", Address: "http://my-service.default.svc:80"},
+		"service2": {Source: "service2", Uri: "kubernetes:services.v1/other/my-service", Error: "no service was found"},
+	}
+
+	result := resolve(discovery.Reference{Source: "service1", Uri: "kubernetes:services.v1/my-service"}, "default", deployed)
+	assert.Equal(t, resolution{Source: "service1", Uri: "kubernetes:services.v1/my-service", Resource: resourceFound, Result: "http://my-service.default.svc:80"}, result)
+
+	result = resolve(discovery.Reference{Source: "service2", Uri: "kubernetes:services.v1/other/my-service"}, "default", deployed)
+	assert.Equal(t, resolution{Source: "service2", Uri: "kubernetes:services.v1/other/my-service", Resource: resourceNotFound, Result: "no service was found"}, result)
+
+	result = resolve(discovery.Reference{Source: "service1", Uri: "kubernetes:services.v1/my-service"}, "default", nil)
+	assert.Equal(t, notDeployed, result.Result)
+
+	result = resolve(discovery.Reference{Source: "invalid", Uri: "kubernetes:--invalid"}, "default", deployed)
+	assert.Empty(t, result.Resource)
+	assert.NotEmpty(t, result.Result)
+
+	dynamicClient.PrependReactor("get", "services", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(schema.GroupResource{Resource: "services"}, "my-service", nil)
+	})
+	result = resolve(discovery.Reference{Source: "service1", Uri: "kubernetes:services.v1/my-service"}, "default", deployed)
+	assert.True(t, strings.HasPrefix(result.Resource, resourceUnknown+": "), result.Resource)
+}
+
+func TestDeployedReferences(t *testing.T) {
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{sonataflowGVR: "SonataFlowList"})
+
+	originalDynamicClient := k8sclient.DynamicClient
+	defer func() {
+		k8sclient.DynamicClient = originalDynamicClient
+	}()
+	k8sclient.DynamicClient = func() (dynamic.Interface, error) {
+		return dynamicClient, nil
+	}
+
+	workflow := &operatorapi.SonataFlow{}
+	workflow.SetName("greeting")
+	workflow.SetNamespace("default")
+	deployed, err := deployedReferences(workflow)
+	assert.NoError(t, err)
+	assert.Nil(t, deployed)
+
+	dynamicClient.PrependReactor("get", "sonataflows", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(sonataflowGVR.GroupResource(), "greeting", nil)
+	})
+	_, err = deployedReferences(workflow)
+	assert.True(t, errors.IsForbidden(err))
+}
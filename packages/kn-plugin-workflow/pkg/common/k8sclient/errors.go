@@ -25,6 +25,13 @@ func (e NoDeploymentFoundError) Error() string {
 	return string(e)
 }
 
+type NoPodFoundError string
+
+func (e NoPodFoundError) Error() string {
+	return string(e)
+}
+
 const (
 	NoDeploymentFound = NoDeploymentFoundError("No deployment found")
+	NoPodFound        = NoPodFoundError("No pod found")
 )
\ No newline at end of file
@@ -387,6 +387,51 @@ func (m GoAPI) PortForward(namespace, serviceName, portFrom, portTo string, onRe
 	return nil
 }
 
+// StreamPodLogs copies the logs of every container of the latest pod matching the label selector to the writer,
+// following them while the containers run if requested.
+func (m GoAPI) StreamPodLogs(namespace, labelSelector string, follow bool, out io.Writer) error {
+	clientSet, err := KubeClient()
+	if err != nil {
+		return err
+	}
+
+	pods, err := clientSet.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("❌ ERROR: Failed to get pods: %v", err)
+	}
+	if len(pods.Items) == 0 {
+		return NoPodFound
+	}
+
+	pod := pods.Items[0]
+	for _, p := range pods.Items[1:] {
+		if p.CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = p
+		}
+	}
+
+	var containers []corev1.Container
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		stream, err := clientSet.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container.Name,
+			Follow:    follow,
+		}).Stream(context.TODO())
+		if err != nil {
+			return fmt.Errorf("❌ ERROR: Failed to get the logs of container %s in pod %s: %v", container.Name, pod.Name, err)
+		}
+		_, err = io.Copy(out, stream)
+		stream.Close()
+		if err != nil {
+			return fmt.Errorf("❌ ERROR: Failed to read the logs of container %s in pod %s: %v", container.Name, pod.Name, err)
+		}
+	}
+	return nil
+}
+
 func (m GoAPI) ExecuteList(gvr schema.GroupVersionResource, namespace string) (*unstructured.UnstructuredList, error) {
 	client, err := DynamicClient()
 	if err != nil {
@@ -449,6 +494,20 @@ var DynamicClient = func() (dynamic.Interface, error) {
 	return dynamicClient, nil
 }
 
+var KubeClient = func() (kubernetes.Interface, error) {
+	config, err := KubeRestConfig()
+	if err != nil {
+		return nil, fmt.Errorf("❌ ERROR: Failed to create rest config for Kubernetes client: %v", err)
+	}
+
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("❌ ERROR: Failed to create k8s client: %v", err)
+	}
+
+	return clientSet, nil
+}
+
 var ParseYamlFile = func(path string) ([]unstructured.Unstructured, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -20,6 +20,8 @@
 package common
 
 import (
+	"io"
+
 	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/common/k8sclient"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -43,6 +45,7 @@ type K8sApi interface {
 	CheckCrdExists(path string) error
 	GetDeploymentStatus(namespace, deploymentName string) (v1.DeploymentStatus, error)
 	PortForward(namespace, serviceName, portFrom, portTo string, onReady func()) error
+	StreamPodLogs(namespace, labelSelector string, follow bool, out io.Writer) error
 }
 
 var Current K8sApi = k8sclient.GoAPI{}
@@ -102,3 +105,7 @@ func GetDeploymentStatus(namespace, deploymentName string) (v1.DeploymentStatus,
 func PortForward(namespace, deploymentName, portFrom, portTo string, onReady func()) error {
 	return Current.PortForward(namespace, deploymentName, portFrom, portTo, onReady)
 }
+
+func StreamPodLogs(namespace, labelSelector string, follow bool, out io.Writer) error {
+	return Current.StreamPodLogs(namespace, labelSelector, follow, out)
+}
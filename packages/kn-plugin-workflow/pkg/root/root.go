@@ -21,6 +21,8 @@ package root
 
 import (
 	"fmt"
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/command/build"
+	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/command/discovery"
 	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/command/operator"
 	"github.com/apache/incubator-kie-tools/packages/kn-plugin-workflow/pkg/command/specs"
 
@@ -80,6 +82,8 @@ func NewRootCommand(cfg RootCmdConfig) *cobra.Command {
 	cmd.AddCommand(command.NewVersionCommand(cfg.Version))
 	cmd.AddCommand(specs.SpecsCommand())
 	cmd.AddCommand(operator.NewOperatorCommand())
+	cmd.AddCommand(discovery.NewDiscoveryCommand())
+	cmd.AddCommand(build.NewBuildCommand())
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		runRootHelp(cmd, args)
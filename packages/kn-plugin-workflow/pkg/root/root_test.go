@@ -51,6 +51,8 @@ func TestNewRootCommand(t *testing.T) {
 			"gen-manifest",
 			"version",
 			"operator",
+			"discovery",
+			"build",
 		}
 
 		cmd := NewRootCommand(cfgTestInputRoot)
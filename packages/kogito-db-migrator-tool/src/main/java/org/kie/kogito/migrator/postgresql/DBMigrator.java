@@ -25,6 +25,9 @@ import jakarta.inject.Inject;
 import io.quarkus.logging.Log;
 import org.flywaydb.core.api.FlywayException;
 
+import java.io.IOException;
+import java.nio.file.Files;
+import java.nio.file.Path;
 import java.sql.SQLException;
 
 import org.eclipse.microprofile.config.inject.ConfigProperty;
@@ -50,8 +53,19 @@ public class DBMigrator implements QuarkusApplication {
     @ConfigProperty(name = "migrate.db.jobsservice")
     Boolean migrateJobsService;
 
+    @ConfigProperty(name = "migrate.report.path")
+    String reportPath;
+
+    MigrationReport report = new MigrationReport();
+
     @Override
     public int run(String... args) {
+        int result = migrate();
+        writeReport();
+        return result;
+    }
+
+    private int migrate() {
         if (migrateDataIndex) {
             try {
                 dbConnectionChecker.checkDataIndexDBConnection();
@@ -62,7 +76,7 @@ public class DBMigrator implements QuarkusApplication {
             }
 
             try{
-                service.migrateDataIndex();
+                report.dataIndex = service.migrateDataIndex();
             } catch ( FlywayException fe ){
                 Log.error( "Error migrating data index database, flyway service exception occured, please check logs.");
                 Quarkus.asyncExit(ERR_DATA_INDEX_MIGRATION);
@@ -80,7 +94,7 @@ public class DBMigrator implements QuarkusApplication {
             }
 
             try{
-                service.migrateJobsService();
+                report.jobsService = service.migrateJobsService();
             } catch ( FlywayException fe ){
                 Log.error( "Error migrating jobs service database, flyway service exception occured, please check logs.");
                 Quarkus.asyncExit(ERR_JOBS_SERVICE_MIGRATION);
@@ -91,4 +105,16 @@ public class DBMigrator implements QuarkusApplication {
         Quarkus.asyncExit(SUCCESS_DB_MIGRATION);
         return SUCCESS_DB_MIGRATION;
     }
-}
\ No newline at end of file
+
+    // the operator reads the report from the termination message of the job pod, even when a migration failed
+    private void writeReport() {
+        if (reportPath == null || reportPath.isBlank()) {
+            return;
+        }
+        try {
+            Files.writeString(Path.of(reportPath), report.toJson());
+        } catch (IOException e) {
+            Log.warn("Failed to write the migration report to " + reportPath + ": " + e.getMessage());
+        }
+    }
+}
@@ -0,0 +1,137 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package org.kie.kogito.migrator.postgresql;
+
+import java.util.ArrayList;
+import java.util.List;
+
+import org.flywaydb.core.api.MigrationInfo;
+
+/**
+ * Summary of the migrations of each service, written as JSON in the container termination message so the
+ * SonataFlow Operator can report it in the platform status.
+ */
+public class MigrationReport {
+
+    // Kubernetes truncates the termination messages longer than 4096 bytes
+    static final int MAX_LENGTH = 4096;
+
+    ServiceReport dataIndex;
+
+    ServiceReport jobsService;
+
+    public static class ServiceReport {
+        final boolean dryRun;
+
+        final List<MigrationInfo> migrations = new ArrayList<>();
+
+        public ServiceReport(boolean dryRun) {
+            this.dryRun = dryRun;
+        }
+
+        void toJson(StringBuilder json) {
+            json.append("{\"dryRun\":").append(dryRun).append(",\"migrations\":[");
+            for (int i = 0; i < migrations.size(); i++) {
+                MigrationInfo migration = migrations.get(i);
+                if (i > 0) {
+                    json.append(',');
+                }
+                json.append('{');
+                if (migration.getVersion() != null) {
+                    appendString(json, "version", migration.getVersion().getVersion()).append(',');
+                }
+                appendString(json, "description", migration.getDescription());
+                if (migration.getChecksum() != null) {
+                    json.append(",\"checksum\":").append(migration.getChecksum());
+                }
+                if (migration.getExecutionTime() != null) {
+                    json.append(",\"executionTimeMillis\":").append(migration.getExecutionTime());
+                }
+                if (migration.getState() != null) {
+                    json.append(',');
+                    appendString(json, "state", migration.getState().getDisplayName());
+                }
+                json.append('}');
+            }
+            json.append("]}");
+        }
+    }
+
+    public String toJson() {
+        String json = serialize();
+        // the oldest migrations are left out until the report fits in the termination message
+        while (json.length() > MAX_LENGTH && dropOldestMigration()) {
+            json = serialize();
+        }
+        return json;
+    }
+
+    private String serialize() {
+        StringBuilder json = new StringBuilder("{");
+        if (dataIndex != null) {
+            json.append("\"dataIndex\":");
+            dataIndex.toJson(json);
+        }
+        if (jobsService != null) {
+            if (dataIndex != null) {
+                json.append(',');
+            }
+            json.append("\"jobsService\":");
+            jobsService.toJson(json);
+        }
+        return json.append('}').toString();
+    }
+
+    private boolean dropOldestMigration() {
+        ServiceReport longest = null;
+        for (ServiceReport report : new ServiceReport[] { dataIndex, jobsService }) {
+            if (report != null && !report.migrations.isEmpty() && (longest == null || report.migrations.size() > longest.migrations.size())) {
+                longest = report;
+            }
+        }
+        if (longest == null) {
+            return false;
+        }
+        longest.migrations.remove(0);
+        return true;
+    }
+
+    private static StringBuilder appendString(StringBuilder json, String name, String value) {
+        json.append('"').append(name).append("\":\"");
+        if (value != null) {
+            for (char c : value.toCharArray()) {
+                switch (c) {
+                    case '"':
+                        json.append("\\\"");
+                        break;
+                    case '\\':
+                        json.append("\\\\");
+                        break;
+                    default:
+                        if (c < 0x20) {
+                            json.append(String.format("\\u%04x", (int) c));
+                        } else {
+                            json.append(c);
+                        }
+                }
+            }
+        }
+        return json.append('"');
+    }
+}
@@ -19,12 +19,19 @@
 
  package org.kie.kogito.migrator.postgresql;
 
+import java.util.Arrays;
+import java.util.Set;
+import java.util.stream.Collectors;
+
 import jakarta.enterprise.context.ApplicationScoped;
 import jakarta.inject.Inject;
 import io.quarkus.logging.Log;
 
 import org.eclipse.microprofile.config.inject.ConfigProperty;
 import org.flywaydb.core.Flyway;
+import org.flywaydb.core.api.MigrationInfo;
+import org.flywaydb.core.api.MigrationInfoService;
+import org.flywaydb.core.api.output.MigrateResult;
 
 import io.quarkus.flyway.FlywayDataSource;
 
@@ -44,23 +51,51 @@ public class MigrationService {
     @ConfigProperty(name = "quarkus.flyway.jobsservice.clean-at-start")
     Boolean cleanJobsService;
 
-    private void migrateDB(Flyway flywayService, Boolean clean, String serviceName) {
+    @ConfigProperty(name = "dry.run.db.dataindex")
+    Boolean dryRunDataIndex;
+
+    @ConfigProperty(name = "dry.run.db.jobsservice")
+    Boolean dryRunJobsService;
+
+    private MigrationReport.ServiceReport migrateDB(Flyway flywayService, Boolean clean, Boolean dryRun, String serviceName) {
+        if (Boolean.TRUE.equals(dryRun)) {
+            // nothing is cleaned nor applied, the pending migrations are only reported
+            Log.info("Validating the pending migrations of " + serviceName);
+            MigrationReport.ServiceReport report = new MigrationReport.ServiceReport(true);
+            MigrationInfoService info = flywayService.info();
+            if (info != null) {
+                report.migrations.addAll(Arrays.asList(info.pending()));
+                Log.info("Found " + report.migrations.size() + " pending migrations");
+            }
+            return report;
+        }
         Log.info("Migrating " + serviceName);
-        if (clean) {
+        if (Boolean.TRUE.equals(clean)) {
             Log.info("Cleaned the " + serviceName);
             flywayService.clean();
         }
-        flywayService.migrate();
-        if (flywayService.info() != null) {
-            Log.info("Migrated to version " + flywayService.info().current().toString());
+        MigrateResult result = flywayService.migrate();
+        MigrationReport.ServiceReport report = new MigrationReport.ServiceReport(false);
+        MigrationInfoService info = flywayService.info();
+        if (info != null) {
+            Log.info("Migrated to version " + info.current().toString());
+            Set<String> migrated = result == null || result.migrations == null ? Set.of()
+                    : result.migrations.stream().map(migration -> migration.version).collect(Collectors.toSet());
+            // the applied migrations carry the checksums and the execution times
+            for (MigrationInfo migration : info.applied()) {
+                if (migration.getVersion() != null && migrated.contains(migration.getVersion().getVersion())) {
+                    report.migrations.add(migration);
+                }
+            }
         }
+        return report;
     }
 
-    public void migrateDataIndex() {
-        migrateDB(flywayDataIndex, cleanDataIndex, "data-index");
+    public MigrationReport.ServiceReport migrateDataIndex() {
+        return migrateDB(flywayDataIndex, cleanDataIndex, dryRunDataIndex, "data-index");
     }
 
-    public void migrateJobsService() {
-        migrateDB(flywayJobsService, cleanJobsService, "jobs-service");
+    public MigrationReport.ServiceReport migrateJobsService() {
+        return migrateDB(flywayJobsService, cleanJobsService, dryRunJobsService, "jobs-service");
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ * 
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ * 
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License. 
+ */
+
+package org.kie.kogito.migrator.postgresql;
+
+import org.flywaydb.core.api.MigrationInfo;
+import org.flywaydb.core.api.MigrationState;
+import org.flywaydb.core.api.MigrationVersion;
+import org.junit.jupiter.api.Test;
+
+import static org.junit.jupiter.api.Assertions.assertEquals;
+import static org.junit.jupiter.api.Assertions.assertTrue;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.when;
+
+class MigrationReportTest {
+
+    private MigrationInfo newMigration(String version, String description) {
+        MigrationInfo migration = mock(MigrationInfo.class);
+        when(migration.getVersion()).thenReturn(MigrationVersion.fromVersion(version));
+        when(migration.getDescription()).thenReturn(description);
+        when(migration.getChecksum()).thenReturn(42);
+        when(migration.getExecutionTime()).thenReturn(10);
+        when(migration.getState()).thenReturn(MigrationState.SUCCESS);
+        return migration;
+    }
+
+    @Test
+    void testToJson() {
+        MigrationReport report = new MigrationReport();
+        report.jobsService = new MigrationReport.ServiceReport(false);
+        report.jobsService.migrations.add(newMigration("1.0", "create \"jobs\" table"));
+
+        assertEquals("{\"jobsService\":{\"dryRun\":false,\"migrations\":[{\"version\":\"1.0\",\"description\":\"create \\\"jobs\\\" table\"," +
+                "\"checksum\":42,\"executionTimeMillis\":10,\"state\":\"Success\"}]}}", report.toJson());
+    }
+
+    @Test
+    void testToJsonFitsTerminationMessage() {
+        MigrationReport report = new MigrationReport();
+        report.dataIndex = new MigrationReport.ServiceReport(true);
+        for (int i = 0; i < 100; i++) {
+            report.dataIndex.migrations.add(newMigration("1." + i, "migration number " + i));
+        }
+
+        String json = report.toJson();
+        assertTrue(json.length() <= MigrationReport.MAX_LENGTH);
+        assertTrue(json.contains("\"version\":\"1.99\""));
+    }
+}
@@ -21,6 +21,8 @@ package org.kie.kogito.migrator.postgresql;
 
 import io.quarkus.test.Mock;
 import org.flywaydb.core.Flyway;
+import org.flywaydb.core.api.MigrationInfo;
+import org.flywaydb.core.api.MigrationInfoService;
 import org.flywaydb.core.api.output.CleanResult;
 import org.flywaydb.core.api.output.MigrateResult;
 import org.junit.jupiter.api.BeforeEach;
@@ -30,6 +32,9 @@ import static org.mockito.Mockito.when;
 import static org.mockito.Mockito.mock;
 import static org.mockito.Mockito.verify;
 import static org.mockito.Mockito.times;
+import static org.mockito.Mockito.never;
+import static org.junit.jupiter.api.Assertions.assertEquals;
+import static org.junit.jupiter.api.Assertions.assertTrue;
 
 class MigrationServiceTest {
     @Mock
@@ -77,4 +82,21 @@ class MigrationServiceTest {
         verify(flyway, times(1)).clean();
         verify(flyway, times(1)).migrate();
     }
+
+    @Test
+    void testDryRunDataIndexReportsPendingMigrations() {
+        MigrationInfoService info = mock(MigrationInfoService.class);
+        MigrationInfo pending = mock(MigrationInfo.class);
+        when(info.pending()).thenReturn(new MigrationInfo[] { pending });
+        when(flyway.info()).thenReturn(info);
+        migrationService.cleanDataIndex = true;
+        migrationService.dryRunDataIndex = true;
+        migrationService.flywayDataIndex = flyway;
+
+        MigrationReport.ServiceReport report = migrationService.migrateDataIndex();
+        verify(flyway, never()).clean();
+        verify(flyway, never()).migrate();
+        assertTrue(report.dryRun);
+        assertEquals(1, report.migrations.size());
+    }
 }
@@ -39,6 +39,8 @@ const (
 	SucceedConditionType ConditionType = "Succeed"
 	// BuiltConditionType describes the condition of a resource that needs to be build.
 	BuiltConditionType ConditionType = "Built"
+	// RolloutConditionType describes the progress of a new revision of a "live" resource replacing the current one.
+	RolloutConditionType ConditionType = "Rollout"
 )
 
 const (
@@ -54,6 +56,10 @@ const (
 	BuildSkippedReason              = "BuildSkipped"
 	BuildSuccessfulReason           = "BuildSuccessful"
 	BuildMarkedToRestartReason      = "BuildMarkedToRestart"
+	RolloutProgressingReason        = "RolloutProgressing"
+	RolloutPromotingReason          = "RolloutPromoting"
+	RolloutSucceededReason          = "RolloutSucceeded"
+	RolloutRolledBackReason         = "RolloutRolledBack"
 )
 
 // Condition describes the common structure for conditions in our types
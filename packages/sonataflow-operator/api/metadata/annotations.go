@@ -39,6 +39,8 @@ const (
 	OperatorIDAnnotation        = Domain + "/operator.id"
 	RestartedAt                 = Domain + "/restartedAt"
 	Checksum                    = Domain + "/checksum-config"
+	PersistenceSecretChecksum   = Domain + "/checksum-persistence-secret"
+	RolloutTemplateHash         = Domain + "/rollout-template-hash"
 )
 
 const (
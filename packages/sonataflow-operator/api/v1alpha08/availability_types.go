@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DisruptionBudgetSpec configures the PodDisruptionBudget generated by the operator to protect the pods from
+// voluntary disruptions, for example, node drains. Only one of MinAvailable or MaxUnavailable can be set.
+type DisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of pods that must still be available after an eviction.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that can be unavailable after an eviction.
+	// Defaults to 1 when MinAvailable is not set.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// TopologySpreadSpec configures the default topology spread constraints added by the operator to the pods.
+// Ignored when the pod template already declares its own topologySpreadConstraints.
+type TopologySpreadSpec struct {
+	// MaxSkew is the maximum permitted difference of pods between any two topology domains. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+	// TopologyKeys are the node label keys used to spread the pods, one constraint is generated for each key.
+	// Defaults to "topology.kubernetes.io/zone" and "kubernetes.io/hostname".
+	// +optional
+	TopologyKeys []string `json:"topologyKeys,omitempty"`
+	// WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy the spread constraint.
+	// Defaults to "ScheduleAnyway".
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	// +optional
+	WhenUnsatisfiable corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
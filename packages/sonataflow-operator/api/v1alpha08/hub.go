@@ -0,0 +1,35 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+// v1alpha08 is the conversion hub and the storage version for every SonataFlow API kind, since the operator
+// controllers are still built on top of it. Other versions convert from and to these types.
+
+// Hub marks this type as a conversion hub.
+func (*SonataFlow) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SonataFlowBuild) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SonataFlowPlatform) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SonataFlowClusterPlatform) Hub() {}
@@ -545,4 +545,10 @@ type PodTemplateSpec struct {
 	PodSpec `json:",inline"`
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+	// DisruptionBudget makes the operator generate a PodDisruptionBudget for the service pods.
+	// +optional
+	DisruptionBudget *DisruptionBudgetSpec `json:"disruptionBudget,omitempty"`
+	// TopologySpread makes the operator add default topology spread constraints to the service pods.
+	// +optional
+	TopologySpread *TopologySpreadSpec `json:"topologySpread,omitempty"`
 }
@@ -0,0 +1,59 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AutoscalingSpec configures the horizontal autoscaling of the workflow pods.
+// In the "kubernetes" deployment model the operator owns a HorizontalPodAutoscaler targeting the workflow Deployment,
+// in the "knative" deployment model the settings are translated into Knative Serving autoscaling annotations.
+type AutoscalingSpec struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilization over all the pods, represented as a
+	// percentage of the requested CPU.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// TargetMemoryUtilizationPercentage is the target average memory utilization over all the pods, represented as a
+	// percentage of the requested memory.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Metrics is the list of custom per pod metrics to scale on. In the "knative" deployment model, only the "concurrency"
+	// and "rps" metrics are supported.
+	// +optional
+	Metrics []AutoscalingMetricSpec `json:"metrics,omitempty"`
+}
+
+// AutoscalingMetricSpec is a custom metric averaged across all the workflow pods.
+type AutoscalingMetricSpec struct {
+	// Name of the metric.
+	Name string `json:"name"`
+	// TargetAverageValue is the target value of the average of the metric across all the pods.
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+// ExposureType is the kind of resource exposing the workflow outside the cluster.
+// +kubebuilder:validation:Enum=ingress;httpRoute
+type ExposureType string
+
+const (
+	// IngressExposureType exposes the workflow with a networking.k8s.io/v1 Ingress
+	IngressExposureType ExposureType = "ingress"
+	// HTTPRouteExposureType exposes the workflow with a Gateway API HTTPRoute
+	HTTPRouteExposureType ExposureType = "httpRoute"
+)
+
+// ExposureSpec describes how a workflow deployed with the "kubernetes" deployment model is exposed outside the cluster.
+// On OpenShift and in the "knative" deployment model the workflows are exposed by the Route and the Knative Service respectively.
+type ExposureSpec struct {
+	// Disabled opts the workflow out of the exposure configured in the platform. Not supported in the platform exposure.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// Type of the resource exposing the workflow.
+	// +optional
+	Type ExposureType `json:"type,omitempty"`
+	// Host the workflow is reachable at. If not set, the Ingress matches any host and the HTTPRoute uses the hostnames
+	// of the parent Gateway listener.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Path prefix the workflow is reachable at. Defaults to "/". The path isn't rewritten, so the workflow must be
+	// served under it, for example, with the quarkus.http.root-path property.
+	// +kubebuilder:validation:Pattern=`^/.*`
+	// +optional
+	Path string `json:"path,omitempty"`
+	// TLSSecretName is the name of the Secret holding the TLS certificate of the host. The HTTPRoute relies on the TLS
+	// termination of the parent Gateway listener instead, setting it only reports an https endpoint.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// IngressClassName is the class of the Ingress. Defaults to the cluster default IngressClass.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// GatewayRef is the parent Gateway of the HTTPRoute. Required by the "httpRoute" type, either in the workflow or in the
+	// platform exposure.
+	// +optional
+	GatewayRef *GatewayReference `json:"gatewayRef,omitempty"`
+	// Annotations added to the generated Ingress or HTTPRoute, for example, to configure the ingress controller.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GatewayReference points to the Gateway API Gateway an HTTPRoute attaches to.
+type GatewayReference struct {
+	// Name of the Gateway.
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the workflow namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the Gateway listener to attach to. Defaults to all the listeners.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// PlatformExposureSpec describes the default exposure of the workflows deployed in the platform namespace.
+type PlatformExposureSpec struct {
+	ExposureSpec `json:",inline"`
+	// Domain the workflows are exposed at when they don't set a host, the workflow host is "<workflow name>.<domain>".
+	// +optional
+	Domain string `json:"domain,omitempty"`
+}
@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutStrategy defines how a new workflow revision replaces the running one.
+// +kubebuilder:validation:Enum=canary;blueGreen
+type RolloutStrategy string
+
+const (
+	// CanaryRolloutStrategy gradually shifts the traffic from the stable to the new revision. Only available in the "knative" deployment model.
+	CanaryRolloutStrategy RolloutStrategy = "canary"
+	// BlueGreenRolloutStrategy runs the new revision side by side with the stable one and switches all the traffic at once after it becomes healthy.
+	BlueGreenRolloutStrategy RolloutStrategy = "blueGreen"
+)
+
+// RolloutPhase describes the progress of a rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing the new revision is being deployed and, for canary rollouts, receiving part of the traffic.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePromoting the new revision passed the health checks and is replacing the stable one.
+	RolloutPhasePromoting RolloutPhase = "Promoting"
+	// RolloutPhaseSucceeded the stable revision serves all the traffic.
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// RolloutPhaseRolledBack the new revision failed, and the stable revision serves all the traffic.
+	RolloutPhaseRolledBack RolloutPhase = "RolledBack"
+)
+
+// RolloutSpec configures how a new workflow revision is rolled out.
+// When not set, the workflow deployment is replaced in place.
+type RolloutSpec struct {
+	// Strategy used to roll out a new revision of the workflow.
+	// +kubebuilder:default:=blueGreen
+	// +optional
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+	// CanarySteps is the list of traffic percentages sent to the new revision, one per step. Ignored by the "blueGreen" strategy.
+	// Defaults to [10, 50].
+	// +optional
+	CanarySteps []int32 `json:"canarySteps,omitempty"`
+	// StepDuration is how long each canary step lasts before moving to the next one. Defaults to 1m.
+	// +optional
+	StepDuration *metav1.Duration `json:"stepDuration,omitempty"`
+	// ProgressDeadline is the maximum time for the new revision to be promoted. Once exceeded, the rollout is rolled back.
+	// Defaults to 10m.
+	// +optional
+	ProgressDeadline *metav1.Duration `json:"progressDeadline,omitempty"`
+}
+
+// RolloutStatus reports the progress of the last rollout of the workflow.
+type RolloutStatus struct {
+	// Phase of the current rollout.
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty"`
+	// StableRevision is the revision serving the traffic before the rollout. A Knative Revision name for the "knative"
+	// deployment model, the pod template hash for the "kubernetes" deployment model.
+	// +optional
+	StableRevision string `json:"stableRevision,omitempty"`
+	// CanaryRevision is the revision being rolled out.
+	// +optional
+	CanaryRevision string `json:"canaryRevision,omitempty"`
+	// FailedRevision is the last revision rolled back. It won't be rolled out again until the workflow changes.
+	// +optional
+	FailedRevision string `json:"failedRevision,omitempty"`
+	// TrafficPercent is the percentage of the traffic sent to the canary revision.
+	// +optional
+	TrafficPercent int32 `json:"trafficPercent,omitempty"`
+	// Step is the index of the current canary step.
+	// +optional
+	Step int32 `json:"step,omitempty"`
+	// StartTime is when the current rollout started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// StepStartTime is when the current canary step started.
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+}
+
+// IsInProgress is true while the rollout is still moving the traffic to the canary revision.
+func (r *RolloutStatus) IsInProgress() bool {
+	return r != nil && (r.Phase == RolloutPhaseProgressing || r.Phase == RolloutPhasePromoting)
+}
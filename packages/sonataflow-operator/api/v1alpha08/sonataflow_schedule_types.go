@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleConcurrencyPolicy describes how the runs of a schedule are handled when the previous one is still running.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ScheduleConcurrencyPolicy string
+
+const (
+	// AllowConcurrentSchedule starts the workflow even if the previous run is still in progress.
+	AllowConcurrentSchedule ScheduleConcurrencyPolicy = "Allow"
+	// ForbidConcurrentSchedule skips the run if the previous one is still in progress.
+	ForbidConcurrentSchedule ScheduleConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrentSchedule cancels the run still in progress and starts the new one.
+	ReplaceConcurrentSchedule ScheduleConcurrencyPolicy = "Replace"
+)
+
+// ScheduleSpec starts a new workflow instance on a cron schedule.
+// The operator implements it with a Knative PingSource when the workflow is deployed with Knative and Knative Eventing
+// is available, or with a Kubernetes CronJob calling the workflow endpoint otherwise.
+type ScheduleSpec struct {
+	// Name identifies the schedule within the workflow. It's used to name the resources implementing the schedule.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	Name string `json:"name"`
+	// Cron expression of the schedule, in the standard five fields format, e.g. "0 2 * * *".
+	Cron string `json:"cron"`
+	// TimeZone the cron expression is evaluated in, e.g. "Europe/Madrid". Defaults to the time zone of the cluster.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Input is the JSON document the workflow instances are started with. Defaults to an empty object.
+	// +optional
+	Input string `json:"input,omitempty"`
+	// ConcurrencyPolicy tells how a run is handled when the previous one is still in progress.
+	// Only honored by the CronJob schedules, PingSource schedules always allow concurrent runs and report the other
+	// policies in the status error.
+	// +kubebuilder:default:=Allow
+	// +optional
+	ConcurrencyPolicy ScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// Suspend stops starting new runs without removing the schedule.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ScheduleStatus reports the state of a workflow schedule.
+type ScheduleStatus struct {
+	// Name of the schedule.
+	Name string `json:"name"`
+	// Resource implementing the schedule, e.g. "CronJob/greeting-nightly".
+	// +optional
+	Resource string `json:"resource,omitempty"`
+	// LastScheduleTime is when the workflow was last started by the schedule. Not reported by the PingSource schedules.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is when the last run successfully started the workflow. Not reported by the PingSource schedules.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// NextScheduleTime is when the workflow will be started next.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// Error describes why the schedule couldn't be created or why its last run failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -53,9 +53,22 @@ type FlowPodTemplateSpec struct {
 	// +optional
 	// Replicas define the number of pods to start by default for this deployment model. Ignored in "knative" deployment model.
 	Replicas *int32 `json:"replicas,omitempty"`
+	// Autoscaling configures the horizontal autoscaling of the workflow pods. When set, Replicas is ignored.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+	// DisruptionBudget makes the operator generate a PodDisruptionBudget for the workflow pods. Ignored in "knative" deployment model.
+	// +optional
+	DisruptionBudget *DisruptionBudgetSpec `json:"disruptionBudget,omitempty"`
+	// TopologySpread makes the operator add default topology spread constraints to the workflow pods. Ignored in "knative" deployment model.
+	// +optional
+	TopologySpread *TopologySpreadSpec `json:"topologySpread,omitempty"`
 	// Defines the kind of deployment model for this pod spec. In dev profile, only "kubernetes" is valid.
 	// +optional
 	DeploymentModel DeploymentModel `json:"deploymentModel,omitempty"`
+	// Rollout configures how a new revision of the workflow replaces the running one. When not set, the workflow
+	// deployment is replaced in place. Ignored in dev profile.
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
 }
 
 // Flow describes the contents of the Workflow definition following the CNCF Serverless Workflow Specification.
@@ -158,12 +171,29 @@ type SonataFlowSpec struct {
 	PodTemplate FlowPodTemplateSpec `json:"podTemplate,omitempty"`
 	// Persistence defines the database persistence configuration for the workflow
 	Persistence *PersistenceOptionsSpec `json:"persistence,omitempty"`
-	// Sink describes the sinkBinding details of this SonataFlow instance.
+	// Sink describes the sinkBinding details of this SonataFlow instance. SinkBindings have no delivery settings, the
+	// sources delivery only applies to the consumed events.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="sink"
 	Sink *duckv1.Destination `json:"sink,omitempty"`
 	// Sources describes the list of sources used to create triggers for events consumed by this SonataFlow instance.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="sources"
 	Sources []SonataFlowSourceSpec `json:"sources,omitempty"`
+	// Kafka produces and consumes the workflow events directly with Kafka topics, instead of Knative Eventing.
+	// Can't be combined with sink and sources.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="kafka"
+	Kafka *KafkaEventingSpec `json:"kafka,omitempty"`
+	// Exposure describes how the workflow is exposed outside the cluster with an Ingress or a Gateway API HTTPRoute.
+	// Overrides the platform exposure. The resulting external URL is reported in the status endpoint.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="exposure"
+	Exposure *ExposureSpec `json:"exposure,omitempty"`
+	// Schedules start new workflow instances on cron schedules.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="schedules"
+	Schedules []ScheduleSpec `json:"schedules,omitempty"`
 }
 
 // SonataFlowSourceSpec defines the desired state of a source used for trigger creation
@@ -173,6 +203,10 @@ type SonataFlowSourceSpec struct {
 	EventType string `json:"eventType"`
 	// Defines the broker used
 	duckv1.Destination `json:",inline"`
+	// Delivery configures the retries and the dead letter sink of the events of this type.
+	// Defaults to the platform eventing delivery.
+	// +optional
+	Delivery *EventDeliverySpec `json:"delivery,omitempty"`
 }
 
 // SonataFlowStatus defines the observed state of SonataFlow
@@ -210,6 +244,15 @@ type SonataFlowStatus struct {
 	LastTimeFinalizerAttempt *metav1.Time `json:"lastTimeFinalizerAttempt,omitempty"`
 	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="lastTimeStatusNotified"
 	LastTimeStatusNotified *metav1.Time `json:"lastTimeStatusNotified,omitempty"`
+	// Rollout displays the progress of the last rollout of the workflow
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="rollout"
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// DiscoveryReferences displays the resolution of the service discovery uris referenced by the workflow properties and functions
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="discoveryReferences"
+	DiscoveryReferences []DiscoveryReferenceStatus `json:"discoveryReferences,omitempty"`
+	// Schedules displays the state of the workflow schedules
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="schedules"
+	Schedules []ScheduleStatus `json:"schedules,omitempty"`
 }
 
 // SonataFlowTriggerRef defines a trigger created for the SonataFlow.
@@ -220,6 +263,28 @@ type SonataFlowTriggerRef struct {
 	// Namespace of the Trigger
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trigger_NS"
 	Namespace string `json:"namespace"`
+	// DeadLetterSinkUri is the resolved URI of the dead letter sink of the Trigger
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trigger_DeadLetterSinkUri"
+	DeadLetterSinkUri *apis.URL `json:"deadLetterSinkUri,omitempty"`
+}
+
+// DiscoveryReferenceStatus defines the resolution of a service discovery uri referenced by the SonataFlow.
+type DiscoveryReferenceStatus struct {
+	// Source name of the property, or the function, referencing the uri
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Discovery_Source"
+	Source string `json:"source"`
+	// Uri the service discovery uri, for example: kubernetes:services.v1/my-namespace/my-service
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Discovery_Uri"
+	Uri string `json:"uri"`
+	// Address the uri was resolved into. Addresses read from secrets are not displayed.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Discovery_Address"
+	Address string `json:"address,omitempty"`
+	// Error produced while parsing or resolving the uri
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Discovery_Error"
+	Error string `json:"error,omitempty"`
 }
 
 func (s *SonataFlowStatus) GetTopLevelConditionType() api.ConditionType {
@@ -280,6 +345,7 @@ func (s *SonataFlowStatus) IsBuildFailed() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:object:generate=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:shortName={"sf", "workflow", "workflows"}
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Profile",type=string,JSONPath=`.metadata.annotations.sonataflow\.org\/profile`
@@ -108,6 +108,25 @@ type SonataFlowBuildStatus struct {
 	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Error"
 	Error string `json:"error,omitempty"`
+	// Logs The tail of the builder logs, captured when the build fails. Its size is bounded, the full logs are available
+	// in the builder pod while it exists.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Logs"
+	Logs string `json:"logs,omitempty"`
+	// ContentDigest The digest of the workflow contents built by this build, when the platform build cache is enabled.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="ContentDigest"
+	ContentDigest string `json:"contentDigest,omitempty"`
+	// Reused Whether the image wasn't built, but reused from a previous build with the same ContentDigest.
+	// A reused image that the workflow deployment can't pull is evicted from the build cache and built again.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Reused"
+	Reused bool `json:"reused,omitempty"`
+	// Signing The digest of the built image, and the references of its signature and SBOM attestation, when the
+	// platform signs the built images. A Reused image keeps the signing status of the build that pushed it.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Signing"
+	Signing *ImageSigningStatus `json:"signing,omitempty"`
 	// InnerBuild is a reference to an internal build object, which can be anything known only to internal builders.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
@@ -115,6 +134,16 @@ type SonataFlowBuildStatus struct {
 	InnerBuild runtime.RawExtension `json:"innerBuild,omitempty" patchStrategy:"replace"`
 }
 
+// ImageSigningStatus describes the signature and the SBOM attestation of a built image.
+type ImageSigningStatus struct {
+	// Digest the digest of the signed image
+	Digest string `json:"digest,omitempty"`
+	// Signature the reference of the image signature in the registry
+	Signature string `json:"signature,omitempty"`
+	// Attestation the reference of the image SBOM attestation in the registry
+	Attestation string `json:"attestation,omitempty"`
+}
+
 // SetInnerBuild use to define a new object pointer to the inner build.
 func (k *SonataFlowBuildStatus) SetInnerBuild(innerBuilder interface{}) error {
 	obj, err := json.Marshal(innerBuilder)
@@ -141,6 +170,7 @@ func (k *SonataFlowBuildStatus) GetInnerBuild(innerBuild interface{}) error {
 // +kubebuilder:object:root=true
 // +kubebuilder:object:generate=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.status.imageTag`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.buildPhase`
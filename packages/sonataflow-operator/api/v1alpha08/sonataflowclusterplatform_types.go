@@ -88,6 +88,7 @@ func (in *SonataFlowClusterPlatformStatus) IsDuplicated() bool {
 // SonataFlowClusterPlatform is the Schema for the sonataflowclusterplatforms API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:printcolumn:name="Platform_Name",type=string,JSONPath=`.spec.platformRef.name`
 // +kubebuilder:printcolumn:name="Platform_NS",type=string,JSONPath=`.spec.platformRef.namespace`
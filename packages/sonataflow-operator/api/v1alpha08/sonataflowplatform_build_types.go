@@ -49,6 +49,9 @@ type BuildPlatformConfig struct {
 	BuildStrategyOptions map[string]string `json:"strategyOptions,omitempty"`
 	// Registry the registry where to publish the built image
 	Registry RegistrySpec `json:"registry,omitempty"`
+	// Signing when defined, the built images are signed and attested with their SBOM after the build.
+	// +optional
+	Signing *ImageSigningSpec `json:"signing,omitempty"`
 }
 
 // GetTimeout returns the specified duration or a default one
@@ -92,6 +95,31 @@ type RegistrySpec struct {
 	Organization string `json:"organization,omitempty"`
 }
 
+// SBOMFormat is the format of the SBOM attested with the built images.
+// +kubebuilder:validation:Enum=spdx;cyclonedx
+type SBOMFormat string
+
+const (
+	// SPDXSBOMFormat SPDX JSON SBOM
+	SPDXSBOMFormat SBOMFormat = "spdx"
+	// CycloneDXSBOMFormat CycloneDX JSON SBOM
+	CycloneDXSBOMFormat SBOMFormat = "cyclonedx"
+)
+
+// ImageSigningSpec configures the cosign signature and the SBOM attestation of the built workflow images.
+type ImageSigningSpec struct {
+	// KeySecret the secret holding the cosign private key under the `cosign.key` key, and its password, if any,
+	// under the `cosign.password` key.
+	KeySecret string `json:"keySecret"`
+	// SBOMFormat the format of the SBOM attested with the image. Defaults to spdx.
+	// +optional
+	SBOMFormat SBOMFormat `json:"sbomFormat,omitempty"`
+	// RegistrySecret the secret with the credentials to push the signature and the attestation, in the
+	// `.dockerconfigjson` format. Defaults to the registry secret.
+	// +optional
+	RegistrySecret string `json:"registrySecret,omitempty"`
+}
+
 type BuildStrategy string
 
 const (
@@ -101,6 +129,9 @@ const (
 	// PlatformBuildStrategy uses the cluster to perform the build.
 	// E.g. on OpenShift, BuildConfig.
 	PlatformBuildStrategy BuildStrategy = "platform"
+	// TektonBuildStrategy uses a Tekton PipelineRun to perform the workflow build.
+	// Tekton Pipelines must be installed in the cluster.
+	TektonBuildStrategy BuildStrategy = "tekton"
 
 	// In the future we can have "custom" which will delegate the build to an external actor provided by the administrator
 	// See https://issues.redhat.com/browse/KOGITO-9084
@@ -66,6 +66,18 @@ type SonataFlowPlatformSpec struct {
 	// Settings for Prometheus monitoring
 	// +optional
 	Monitoring *PlatformMonitoringOptionsSpec `json:"monitoring,omitempty"`
+	// Exposure defines the default exposure of the workflows deployed in the platform namespace.
+	// Workflows can override any of these settings in their own exposure.
+	// +optional
+	Exposure *PlatformExposureSpec `json:"exposure,omitempty"`
+	// TLS secures the communication between the workflows, the Data Index and the Jobs Service with HTTPS.
+	// +optional
+	TLS *PlatformTLSSpec `json:"tls,omitempty"`
+	// NetworkPolicy enables the generation of the NetworkPolicies allowing the traffic between the workflows, the Data Index,
+	// the Jobs Service, the Knative Eventing brokers and the services discovered by the workflows. The pods of the discovered
+	// services are only selected when the namespace policies already isolate them, so their other clients aren't cut off.
+	// +optional
+	NetworkPolicy *PlatformNetworkPolicySpec `json:"networkPolicy,omitempty"`
 }
 
 // PlatformEventingSpec specifies the Knative Eventing integration details in the platform.
@@ -75,6 +87,16 @@ type PlatformEventingSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="broker"
 	Broker *duckv1.Destination `json:"broker,omitempty"`
+	// Kafka is the default Kafka cluster of the workflows producing and consuming their events directly with Kafka
+	// topics. Workflows with no sink nor sources use it when it's defined. Can't be combined with broker.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="kafka"
+	Kafka *KafkaClusterSpec `json:"kafka,omitempty"`
+	// Delivery is the default delivery of the events consumed with the broker, applied to the workflows, Dataindex,
+	// and Jobservice Triggers.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="delivery"
+	Delivery *EventDeliverySpec `json:"delivery,omitempty"`
 }
 
 // PlatformMonitoringOptionsSpec specifies the settings for monitoring
@@ -86,6 +108,21 @@ type PlatformMonitoringOptionsSpec struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+// PlatformNetworkPolicySpec specifies the generation of NetworkPolicies for the workflows and the platform services.
+// The generated policies only declare the ingress traffic the operator knows about, any other traffic must be allowed
+// by the policies of the namespace owner.
+// +k8s:openapi-gen=true
+type PlatformNetworkPolicySpec struct {
+	// Enabled indicates whether the NetworkPolicies are generated
+	// +optional
+	// +default: false
+	Enabled bool `json:"enabled,omitempty"`
+	// EventingNamespace is the namespace of the Knative Eventing data plane delivering the broker events to the
+	// workflows and the platform services. Defaults to knative-eventing.
+	// +optional
+	EventingNamespace string `json:"eventingNamespace,omitempty"`
+}
+
 // PlatformCluster is the kind of orchestration cluster the platform is installed into
 // +kubebuilder:validation:Enum=kubernetes;openshift
 type PlatformCluster string
@@ -111,22 +148,54 @@ const (
 	DBMigrationStatusInProgress DBMigrationStatus = "In-Progress"
 	DBMigrationStatusSucceeded  DBMigrationStatus = "Succeeded"
 	DBMigrationStatusFailed     DBMigrationStatus = "Failed"
+	DBMigrationStatusValidated  DBMigrationStatus = "Validated"
 
 	MessageDBMigrationStatusStarted    string = "Started the database migrations for the services"
 	MessageDBMigrationStatusInProgress string = "The database migrations for the services are in-progress"
 	MessageDBMigrationStatusSucceeded  string = "The database migrations for the services are successful"
 	MessageDBMigrationStatusFailed     string = "The database migrations for the services have failed"
+	MessageDBMigrationStatusValidated  string = "The pending database migrations for the services are valid, none has been applied"
 
 	ReasonDBMigrationStatusStarted    string = "Started by SonataFlow operator"
 	ReasonDBMigrationStatusInProgress string = "The database migration job is in-progress"
 	ReasonDBMigrationStatusSucceeded  string = "The database migration job completed as expected"
 	ReasonDBMigrationStatusFailed     string = "The database may be unreachable, invalid credentials supplied or flyway migration failed. Please check logs for further details."
+	ReasonDBMigrationStatusRetrying   string = "The database migration job is retrying after a failed attempt"
+	ReasonDBMigrationStatusValidated  string = "The database migration job completed in dry run mode"
 )
 
 type SonataFlowPlatformDBMigrationPhase struct {
 	Status  DBMigrationStatus `json:"dbMigrationStatus,omitempty"`
 	Message string            `json:"message,omitempty"`
 	Reason  string            `json:"reason,omitempty"`
+	// FailedAttempts number of failed attempts of the database migration job
+	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+	// DataIndex migrations reported by the database migration job
+	DataIndex *DBMigrationServiceStatus `json:"dataIndex,omitempty"`
+	// JobsService migrations reported by the database migration job
+	JobsService *DBMigrationServiceStatus `json:"jobsService,omitempty"`
+}
+
+// DBMigrationServiceStatus describes the database migrations of a platform service.
+type DBMigrationServiceStatus struct {
+	// DryRun whether the migrations were only validated
+	DryRun bool `json:"dryRun,omitempty"`
+	// Migrations applied, or pending in dry run mode, in the order reported by Flyway
+	Migrations []FlywayMigration `json:"migrations,omitempty"`
+}
+
+// FlywayMigration describes a single Flyway migration.
+type FlywayMigration struct {
+	// Version of the migration
+	Version string `json:"version,omitempty"`
+	// Description of the migration
+	Description string `json:"description,omitempty"`
+	// Checksum calculated by Flyway over the migration script
+	Checksum *int32 `json:"checksum,omitempty"`
+	// ExecutionTimeMillis time taken to apply the migration
+	ExecutionTimeMillis int64 `json:"executionTimeMillis,omitempty"`
+	// State as reported by Flyway, e.g. Success, Pending or Failed
+	State string `json:"state,omitempty"`
 }
 
 // SonataFlowPlatformStatus defines the observed state of SonataFlowPlatform
@@ -230,6 +299,7 @@ func (in *SonataFlowPlatformStatus) IsFailure() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:object:generate=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:shortName={"sfp", "sfplatform", "sfplatforms"}
 // +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.status.cluster`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=='Succeed')].status`
@@ -0,0 +1,210 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// v1beta1 shares the v1alpha08 JSON representation except for the fields cleaned up in this version.
+// Hence, the common parts are converted through their JSON encoding and the cleaned up fields are converted explicitly.
+
+var (
+	_ conversion.Convertible = &SonataFlow{}
+	_ conversion.Convertible = &SonataFlowBuild{}
+	_ conversion.Convertible = &SonataFlowPlatform{}
+	_ conversion.Convertible = &SonataFlowClusterPlatform{}
+)
+
+// ConvertTo converts this SonataFlow to the hub version (v1alpha08).
+func (in *SonataFlow) ConvertTo(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlow)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlow but got %T", hubRaw)
+	}
+	in.ObjectMeta.DeepCopyInto(&hub.ObjectMeta)
+	if err := convertByJSON(&in.Spec, &hub.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&in.Status, &hub.Status)
+}
+
+// ConvertFrom converts from the hub version (v1alpha08) to this SonataFlow.
+func (in *SonataFlow) ConvertFrom(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlow)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlow but got %T", hubRaw)
+	}
+	hub.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+	if err := convertByJSON(&hub.Spec, &in.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&hub.Status, &in.Status)
+}
+
+// ConvertTo converts this SonataFlowBuild to the hub version (v1alpha08).
+func (in *SonataFlowBuild) ConvertTo(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowBuild)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowBuild but got %T", hubRaw)
+	}
+	in.ObjectMeta.DeepCopyInto(&hub.ObjectMeta)
+	if err := convertByJSON(&in.Spec, &hub.Spec); err != nil {
+		return err
+	}
+	status := in.Status.DeepCopy()
+	status.InnerBuild = nil
+	if err := convertByJSON(status, &hub.Status); err != nil {
+		return err
+	}
+	if in.Status.InnerBuild != nil && in.Status.InnerBuild.State != nil {
+		in.Status.InnerBuild.State.DeepCopyInto(&hub.Status.InnerBuild)
+	}
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1alpha08) to this SonataFlowBuild.
+func (in *SonataFlowBuild) ConvertFrom(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowBuild)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowBuild but got %T", hubRaw)
+	}
+	hub.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+	if err := convertByJSON(&hub.Spec, &in.Spec); err != nil {
+		return err
+	}
+	status := hub.Status.DeepCopy()
+	status.InnerBuild = runtime.RawExtension{}
+	if err := convertByJSON(status, &in.Status); err != nil {
+		return err
+	}
+	innerBuild, err := innerBuildFromRaw(hub.Status.InnerBuild)
+	if err != nil {
+		return err
+	}
+	in.Status.InnerBuild = innerBuild
+	return nil
+}
+
+// ConvertTo converts this SonataFlowPlatform to the hub version (v1alpha08).
+func (in *SonataFlowPlatform) ConvertTo(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowPlatform)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowPlatform but got %T", hubRaw)
+	}
+	in.ObjectMeta.DeepCopyInto(&hub.ObjectMeta)
+	if err := convertByJSON(&in.Spec, &hub.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&in.Status, &hub.Status)
+}
+
+// ConvertFrom converts from the hub version (v1alpha08) to this SonataFlowPlatform.
+func (in *SonataFlowPlatform) ConvertFrom(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowPlatform)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowPlatform but got %T", hubRaw)
+	}
+	hub.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+	if err := convertByJSON(&hub.Spec, &in.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&hub.Status, &in.Status)
+}
+
+// ConvertTo converts this SonataFlowClusterPlatform to the hub version (v1alpha08).
+func (in *SonataFlowClusterPlatform) ConvertTo(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowClusterPlatform)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowClusterPlatform but got %T", hubRaw)
+	}
+	in.ObjectMeta.DeepCopyInto(&hub.ObjectMeta)
+	if err := convertByJSON(&in.Spec, &hub.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&in.Status, &hub.Status)
+}
+
+// ConvertFrom converts from the hub version (v1alpha08) to this SonataFlowClusterPlatform.
+func (in *SonataFlowClusterPlatform) ConvertFrom(hubRaw conversion.Hub) error {
+	hub, ok := hubRaw.(*v1alpha08.SonataFlowClusterPlatform)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha08 SonataFlowClusterPlatform but got %T", hubRaw)
+	}
+	hub.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+	if err := convertByJSON(&hub.Spec, &in.Spec); err != nil {
+		return err
+	}
+	return convertByJSON(&hub.Status, &in.Status)
+}
+
+// innerBuildFromRaw reads the well-known attributes of the internal build objects stored by the v1alpha08 builders:
+// full objects, like the ContainerBuild, or references, like the OpenShift Build.
+func innerBuildFromRaw(raw runtime.RawExtension) (*InnerBuildStatus, error) {
+	if len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	innerBuild := struct {
+		APIVersion string `json:"apiVersion,omitempty"`
+		APIGroup   string `json:"apiGroup,omitempty"`
+		Kind       string `json:"kind,omitempty"`
+		Name       string `json:"name,omitempty"`
+		Metadata   struct {
+			Name string `json:"name,omitempty"`
+		} `json:"metadata,omitempty"`
+		Status struct {
+			Phase string `json:"phase,omitempty"`
+		} `json:"status,omitempty"`
+	}{}
+	if err := json.Unmarshal(raw.Raw, &innerBuild); err != nil {
+		return nil, fmt.Errorf("failed to read the inner build status: %w", err)
+	}
+	status := &InnerBuildStatus{
+		APIVersion: innerBuild.APIVersion,
+		Kind:       innerBuild.Kind,
+		Name:       innerBuild.Name,
+		Phase:      innerBuild.Status.Phase,
+		State:      raw.DeepCopy(),
+	}
+	if len(status.APIVersion) == 0 {
+		// typed references hold the group version in the apiGroup attribute
+		status.APIVersion = innerBuild.APIGroup
+	}
+	if len(status.Name) == 0 {
+		status.Name = innerBuild.Metadata.Name
+	}
+	return status, nil
+}
+
+// convertByJSON converts src into dst, which must share the same JSON representation.
+func convertByJSON[S any, D any](src *S, dst *D) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	*dst = *new(D)
+	return json.Unmarshal(raw, dst)
+}
@@ -0,0 +1,221 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+const (
+	camelWorkflowCR   = "../v1alpha08/testdata/sonataflow-camel.yaml"
+	foreachWorkflowCR = "../v1alpha08/testdata/sonataflow-foreach.yaml"
+)
+
+func getHubWorkflowCR(t *testing.T, name string) *v1alpha08.SonataFlow {
+	crBytes, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflowCR := &v1alpha08.SonataFlow{}
+	if err = yaml.Unmarshal(crBytes, workflowCR); err != nil {
+		t.Fatal(err)
+	}
+	return workflowCR
+}
+
+// assertRoundTrip converts the hub to the given spoke and back, expecting the very same serialized hub object.
+// The API server sets the objects' kind after the conversion, and serializes timestamps with seconds precision.
+func assertRoundTrip(t *testing.T, hub conversion.Hub, spoke conversion.Convertible, roundTrip conversion.Hub) {
+	hub.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if err := spoke.ConvertTo(roundTrip); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	expected, err := json.Marshal(hub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(roundTrip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(expected) != string(got) {
+		t.Errorf("round trip mismatch\nexpected: %s\ngot:      %s", expected, got)
+	}
+}
+
+func TestSonataFlow_RoundTrip(t *testing.T) {
+	for _, name := range []string{camelWorkflowCR, foreachWorkflowCR} {
+		t.Run(name, func(t *testing.T) {
+			hub := getHubWorkflowCR(t, name)
+			hub.Spec.Persistence = &v1alpha08.PersistenceOptionsSpec{
+				PostgreSQL: &v1alpha08.PersistencePostgreSQL{
+					SecretRef: v1alpha08.PostgreSQLSecretOptions{Name: "db-secret"},
+					JdbcUrl:   "jdbc:postgresql://db:5432/sonataflow",
+				},
+				DBMigrationStrategy: string(v1alpha08.DBMigrationStrategyJob),
+			}
+			hub.Status.Manager().MarkTrue(api.RunningConditionType)
+			spoke := &SonataFlow{}
+			assertRoundTrip(t, hub, spoke, &v1alpha08.SonataFlow{})
+			if spoke.Spec.Persistence.DBMigrationStrategy != DBMigrationStrategyJob {
+				t.Errorf("expected the %s db migration strategy, got %s", DBMigrationStrategyJob, spoke.Spec.Persistence.DBMigrationStrategy)
+			}
+			if spoke.Spec.Flow.States[0].Name != hub.Spec.Flow.States[0].Name {
+				t.Errorf("expected the %s state, got %s", hub.Spec.Flow.States[0].Name, spoke.Spec.Flow.States[0].Name)
+			}
+		})
+	}
+}
+
+func TestSonataFlowBuild_RoundTrip(t *testing.T) {
+	apiGroup := "build.openshift.io/v1"
+	tests := []struct {
+		name       string
+		innerBuild interface{}
+		expected   *InnerBuildStatus
+	}{
+		{
+			name: "container build",
+			innerBuild: map[string]interface{}{
+				"apiVersion": "sonataflow.org/v1alpha08",
+				"kind":       "ContainerBuild",
+				"metadata":   map[string]interface{}{"name": "greeting"},
+				"status":     map[string]interface{}{"phase": "Running"},
+			},
+			expected: &InnerBuildStatus{APIVersion: "sonataflow.org/v1alpha08", Kind: "ContainerBuild", Name: "greeting", Phase: "Running"},
+		},
+		{
+			name:       "openshift build reference",
+			innerBuild: &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "Build", Name: "greeting-1"},
+			expected:   &InnerBuildStatus{APIVersion: apiGroup, Kind: "Build", Name: "greeting-1"},
+		},
+		{
+			name: "no inner build",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &v1alpha08.SonataFlowBuild{
+				ObjectMeta: metav1.ObjectMeta{Name: "greeting", Namespace: "default"},
+				Spec: v1alpha08.SonataFlowBuildSpec{
+					BuildTemplate: v1alpha08.BuildTemplate{BuildArgs: []corev1.EnvVar{{Name: "QUARKUS_EXTENSIONS", Value: "io.quarkus:quarkus-jdbc-postgresql"}}},
+				},
+				Status: v1alpha08.SonataFlowBuildStatus{ImageTag: "quay.io/kiegroup/greeting:latest", BuildPhase: v1alpha08.BuildPhaseRunning},
+			}
+			if tt.innerBuild != nil {
+				if err := hub.Status.SetInnerBuild(tt.innerBuild); err != nil {
+					t.Fatal(err)
+				}
+			}
+			spoke := &SonataFlowBuild{}
+			assertRoundTrip(t, hub, spoke, &v1alpha08.SonataFlowBuild{})
+			if tt.expected == nil {
+				if spoke.Status.InnerBuild != nil {
+					t.Errorf("expected no inner build, got %v", spoke.Status.InnerBuild)
+				}
+				return
+			}
+			tt.expected.State = &runtime.RawExtension{Raw: hub.Status.InnerBuild.Raw}
+			if !reflect.DeepEqual(tt.expected, spoke.Status.InnerBuild) {
+				t.Errorf("expected inner build %v, got %v", tt.expected, spoke.Status.InnerBuild)
+			}
+		})
+	}
+}
+
+func TestSonataFlowPlatform_RoundTrip(t *testing.T) {
+	enabled := true
+	hub := &v1alpha08.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonataflow-platform", Namespace: "default"},
+		Spec: v1alpha08.SonataFlowPlatformSpec{
+			Build: v1alpha08.BuildPlatformSpec{Config: v1alpha08.BuildPlatformConfig{
+				BuildStrategy: v1alpha08.OperatorBuildStrategy,
+				Registry:      v1alpha08.RegistrySpec{Address: "quay.io", Organization: "kiegroup"},
+			}},
+			Services: &v1alpha08.ServicesPlatformSpec{
+				DataIndex: &v1alpha08.DataIndexServiceSpec{ServiceSpec: v1alpha08.ServiceSpec{
+					Enabled: &enabled,
+					Persistence: &v1alpha08.PersistenceOptionsSpec{
+						PostgreSQL: &v1alpha08.PersistencePostgreSQL{
+							SecretRef: v1alpha08.PostgreSQLSecretOptions{Name: "db-secret"},
+							ServiceRef: &v1alpha08.PostgreSQLServiceOptions{
+								SQLServiceOptions: &v1alpha08.SQLServiceOptions{Name: "db"},
+								DatabaseSchema:    "data-index-service",
+							},
+						},
+						DBMigrationStrategy: string(v1alpha08.DBMigrationStrategyService),
+					},
+				}},
+			},
+			Properties: &v1alpha08.PropertyPlatformSpec{Flow: []v1alpha08.PropertyVar{{Name: "quarkus.log.level", Value: "INFO"}}},
+		},
+		Status: v1alpha08.SonataFlowPlatformStatus{Cluster: v1alpha08.PlatformClusterKubernetes, Version: "0.8"},
+	}
+	hub.Status.Manager().MarkTrue(api.SucceedConditionType)
+	assertRoundTrip(t, hub, &SonataFlowPlatform{}, &v1alpha08.SonataFlowPlatform{})
+}
+
+func TestSonataFlowClusterPlatform_RoundTrip(t *testing.T) {
+	hub := &v1alpha08.SonataFlowClusterPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec: v1alpha08.SonataFlowClusterPlatformSpec{
+			PlatformRef:  v1alpha08.SonataFlowPlatformRef{Name: "sonataflow-platform", Namespace: "default"},
+			Capabilities: &v1alpha08.SonataFlowClusterPlatformCapSpec{Workflows: []v1alpha08.WorkFlowCapability{"services"}},
+		},
+	}
+	hub.Status.Manager().MarkTrue(api.SucceedConditionType)
+	assertRoundTrip(t, hub, &SonataFlowClusterPlatform{}, &v1alpha08.SonataFlowClusterPlatform{})
+}
+
+func TestSonataFlowBuild_ConvertTo(t *testing.T) {
+	spoke := &SonataFlowBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeting", Namespace: "default"},
+		Status: SonataFlowBuildStatus{
+			BuildPhase: BuildPhaseSucceeded,
+			InnerBuild: &InnerBuildStatus{Kind: "ContainerBuild", State: &runtime.RawExtension{Raw: []byte(`{"kind":"ContainerBuild"}`)}},
+		},
+	}
+	hub := &v1alpha08.SonataFlowBuild{}
+	if err := spoke.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if hub.Status.BuildPhase != v1alpha08.BuildPhaseSucceeded {
+		t.Errorf("expected the %s phase, got %s", v1alpha08.BuildPhaseSucceeded, hub.Status.BuildPhase)
+	}
+	if string(hub.Status.InnerBuild.Raw) != `{"kind":"ContainerBuild"}` {
+		t.Errorf("unexpected inner build %s", hub.Status.InnerBuild.Raw)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// BackoffPolicyType is the policy of the delay between the event delivery retries.
+// +kubebuilder:validation:Enum=linear;exponential
+type BackoffPolicyType string
+
+const (
+	// BackoffPolicyLinear delays every retry by backoffDelay * <numberOfRetries>.
+	BackoffPolicyLinear BackoffPolicyType = "linear"
+	// BackoffPolicyExponential delays every retry by backoffDelay * 2^<numberOfRetries>.
+	BackoffPolicyExponential BackoffPolicyType = "exponential"
+)
+
+// EventDeliverySpec describes how the events are delivered to the workflows: how many times, how often, and where the
+// undelivered ones go. It's applied to the Knative Triggers of the consumed events. The produced events are sent through
+// a SinkBinding, which has no delivery settings, so their retries depend on the sink, e.g. the broker.
+type EventDeliverySpec struct {
+	// Retry is the minimum number of retries the sender should attempt when sending an event before moving it to the
+	// dead letter sink.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retry *int32 `json:"retry,omitempty"`
+	// BackoffPolicy is the retry backoff policy, linear or exponential.
+	// +optional
+	BackoffPolicy *BackoffPolicyType `json:"backoffPolicy,omitempty"`
+	// BackoffDelay is the delay before retrying, as an ISO-8601 duration, e.g. "PT1S".
+	// +optional
+	BackoffDelay *string `json:"backoffDelay,omitempty"`
+	// DeadLetterSink is the sink receiving the events that couldn't be delivered.
+	// +optional
+	DeadLetterSink *duckv1.Destination `json:"deadLetterSink,omitempty"`
+}
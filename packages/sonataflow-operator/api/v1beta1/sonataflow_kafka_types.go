@@ -0,0 +1,68 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+// KafkaClusterSpec describes the Kafka cluster the workflows produce and consume their events with, without Knative Eventing.
+type KafkaClusterSpec struct {
+	// BootstrapServers is the comma separated list of the Kafka brokers, e.g. "my-cluster-kafka-bootstrap.kafka:9092".
+	// +optional
+	BootstrapServers string `json:"bootstrapServers,omitempty"`
+	// Strimzi creates a Strimzi KafkaTopic for every topic the workflows produce or consume events with.
+	// +optional
+	Strimzi *StrimziTopicsSpec `json:"strimzi,omitempty"`
+}
+
+// StrimziTopicsSpec describes the Strimzi KafkaTopic resources created for the workflow topics.
+type StrimziTopicsSpec struct {
+	// ClusterName is the name of the Strimzi Kafka resource the topics belong to.
+	ClusterName string `json:"clusterName"`
+	// Namespace watched by the Strimzi Topic Operator, where the KafkaTopic resources are created.
+	// Defaults to the workflow namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Partitions of the created topics. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Partitions *int32 `json:"partitions,omitempty"`
+	// Replicas of the created topics. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// KafkaEventingSpec maps the events the workflow produces and consumes to Kafka topics.
+// The cluster settings not defined here are taken from the platform eventing Kafka.
+type KafkaEventingSpec struct {
+	KafkaClusterSpec `json:",inline"`
+	// Topics maps the workflow event types to Kafka topics. The events not listed are mapped to a topic named after
+	// their type.
+	// +optional
+	// +listType=map
+	// +listMapKey=eventType
+	Topics []KafkaTopicSpec `json:"topics,omitempty"`
+}
+
+// KafkaTopicSpec maps an event type to a Kafka topic.
+type KafkaTopicSpec struct {
+	// EventType is the CloudEvent type of the workflow event, as defined in spec.flow.events.
+	EventType string `json:"eventType"`
+	// Topic the events of the given type are produced to, or consumed from.
+	Topic string `json:"topic"`
+}
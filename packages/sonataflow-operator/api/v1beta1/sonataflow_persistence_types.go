@@ -0,0 +1,198 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DBMigrationStrategyType is the approach used to migrate the platform services database.
+// +kubebuilder:validation:Enum=service;job;none
+type DBMigrationStrategyType string
+
+const (
+	DBMigrationStrategyService DBMigrationStrategyType = "service"
+	DBMigrationStrategyJob     DBMigrationStrategyType = "job"
+	DBMigrationStrategyNone    DBMigrationStrategyType = "none"
+)
+
+// PlatformPersistenceOptionsSpec configures the DataBase in the platform spec. This specification can
+// be used by workflows and platform services when they don't provide one of their own.
+// +optional
+// +kubebuilder:validation:MaxProperties=1
+type PlatformPersistenceOptionsSpec struct {
+	// Connect configured services to a postgresql database.
+	// +optional
+	PostgreSQL *PlatformPersistencePostgreSQL `json:"postgresql,omitempty"`
+	// Connect configured services to a MySQL or MariaDB database.
+	// +optional
+	MySQL *PersistenceMySQL `json:"mysql,omitempty"`
+}
+
+// PlatformPersistencePostgreSQL configure postgresql connection in a platform to be shared
+// by platform services and workflows when required.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=3
+type PlatformPersistencePostgreSQL struct {
+	// Secret reference to the database user credentials. Filled by the operator when managed is set.
+	// +optional
+	SecretRef PostgreSQLSecretOptions `json:"secretRef,omitempty"`
+	// Service reference to postgresql datasource. Mutually exclusive to jdbcUrl.
+	// +optional
+	ServiceRef *SQLServiceOptions `json:"serviceRef,omitempty"`
+	// PostgreSql JDBC URL. Mutually exclusive to serviceRef.
+	// e.g. "jdbc:postgresql://host:port/database?currentSchema=data-index-service"
+	// +optional
+	JdbcUrl string `json:"jdbcUrl,omitempty"`
+	// Managed makes the operator deploy a PostgreSQL instance owned by the platform, and wire its secretRef and
+	// serviceRef automatically. Mutually exclusive to jdbcUrl.
+	// +optional
+	Managed *ManagedPostgreSQLSpec `json:"managed,omitempty"`
+}
+
+// ManagedPostgreSQLSpec configures the PostgreSQL instance deployed by the operator. It runs a single replica with no
+// backups nor high availability, so it's meant for development and test namespaces only.
+// The instance, its storage and credentials are removed along with the SonataFlowPlatform.
+type ManagedPostgreSQLSpec struct {
+	// Container image of the PostgreSQL instance. Defaults to the operator's managedPostgreSQLImageTag configuration.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Size of the PersistentVolumeClaim holding the database files. Defaults to 1Gi.
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// StorageClassName of the PersistentVolumeClaim. The cluster default is used if not set.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// PersistenceOptionsSpec configures the DataBase support for both platform services and workflows. For services, it allows
+// configuring a generic database connectivity if the service does not come with its own configured. In case of workflows,
+// the operator will add the necessary JDBC properties to in the workflow's application.properties so that it can communicate
+// with the persistence service based on the spec provided here.
+// +optional
+// +kubebuilder:validation:MaxProperties=3
+type PersistenceOptionsSpec struct {
+	// Connect configured services to a postgresql database.
+	// +optional
+	PostgreSQL *PersistencePostgreSQL `json:"postgresql,omitempty"`
+	// Connect configured services to a MySQL or MariaDB database.
+	// +optional
+	MySQL *PersistenceMySQL `json:"mysql,omitempty"`
+
+	// DB Migration approach for data-index and jobs-service. Use the following values as described.
+	// job: use job based approach provided by the SonataFlow operator, only supported with PostgreSQL.
+	// service: service itself shall migrate the db and will not use SonataFlow operator.
+	// none: no database migration functionality needed.
+	// +optional
+	// +kubebuilder:default:=service
+	DBMigrationStrategy DBMigrationStrategyType `json:"dbMigrationStrategy,omitempty"`
+	// Options of the job based database migration, only considered when dbMigrationStrategy is job.
+	// +optional
+	DBMigration *DBMigrationOptions `json:"dbMigration,omitempty"`
+}
+
+// DBMigrationOptions configures the database migration job run by the operator for a platform service.
+type DBMigrationOptions struct {
+	// DryRun only validates the pending migrations without applying them, they are reported in the platform status.
+	// The service is neither deployed nor updated while enabled.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// BackoffLimit number of retries of the migration job, for example, on transient connection failures.
+	// Retries are delayed with an exponential backoff. Defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+}
+
+// PersistencePostgreSQL configure postgresql connection for service(s).
+// +kubebuilder:validation:MinProperties=2
+// +kubebuilder:validation:MaxProperties=2
+type PersistencePostgreSQL struct {
+	// Secret reference to the database user credentials
+	SecretRef PostgreSQLSecretOptions `json:"secretRef"`
+	// Service reference to postgresql datasource. Mutually exclusive to jdbcUrl.
+	// +optional
+	ServiceRef *PostgreSQLServiceOptions `json:"serviceRef,omitempty"`
+	// PostgreSql JDBC URL. Mutually exclusive to serviceRef.
+	// e.g. "jdbc:postgresql://host:port/database?currentSchema=data-index-service"
+	// +optional
+	JdbcUrl string `json:"jdbcUrl,omitempty"`
+}
+
+// PostgreSQLSecretOptions use credential secret for postgresql connection.
+type PostgreSQLSecretOptions struct {
+	// Name of the postgresql credentials secret.
+	Name string `json:"name"`
+	// Defaults to POSTGRESQL_USER
+	// +optional
+	UserKey string `json:"userKey,omitempty"`
+	// Defaults to POSTGRESQL_PASSWORD
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
+type SQLServiceOptions struct {
+	// Name of the database k8s service.
+	Name string `json:"name"`
+	// Namespace of the database k8s service. Defaults to the SonataFlowPlatform's local namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Port to use when connecting to the database k8s service. Defaults to 5432 for postgresql and 3306 for mysql.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// Name of the database to be used. Defaults to "sonataflow"
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+}
+
+// PostgreSQLServiceOptions use k8s service to configure postgresql jdbc url.
+type PostgreSQLServiceOptions struct {
+	*SQLServiceOptions `json:",inline"`
+	// Schema of postgresql database to be used. Defaults to "data-index-service"
+	// +optional
+	DatabaseSchema string `json:"databaseSchema,omitempty"`
+}
+
+// PersistenceMySQL configure MySQL or MariaDB connection for service(s) and workflows.
+// MySQL doesn't distinguish schemas from databases, so each service or workflow sharing the same ServiceRef
+// uses the same database.
+// +kubebuilder:validation:MinProperties=2
+// +kubebuilder:validation:MaxProperties=2
+type PersistenceMySQL struct {
+	// Secret reference to the database user credentials
+	SecretRef MySQLSecretOptions `json:"secretRef"`
+	// Service reference to mysql datasource. Mutually exclusive to jdbcUrl.
+	// +optional
+	ServiceRef *SQLServiceOptions `json:"serviceRef,omitempty"`
+	// MySQL JDBC URL. Mutually exclusive to serviceRef.
+	// e.g. "jdbc:mysql://host:port/database"
+	// +optional
+	JdbcUrl string `json:"jdbcUrl,omitempty"`
+}
+
+// MySQLSecretOptions use credential secret for mysql connection.
+type MySQLSecretOptions struct {
+	// Name of the mysql credentials secret.
+	Name string `json:"name"`
+	// Defaults to MYSQL_USER
+	// +optional
+	UserKey string `json:"userKey,omitempty"`
+	// Defaults to MYSQL_PASSWORD
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
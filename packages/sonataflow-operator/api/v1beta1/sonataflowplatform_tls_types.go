@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+// TLSClientAuth is the client certificate authentication enforced by the HTTPS endpoints.
+// +kubebuilder:validation:Enum=none;request;required
+type TLSClientAuth string
+
+const (
+	// TLSClientAuthNone doesn't request the client certificate
+	TLSClientAuthNone TLSClientAuth = "none"
+	// TLSClientAuthRequest requests the client certificate, but accepts clients without one
+	TLSClientAuthRequest TLSClientAuth = "request"
+	// TLSClientAuthRequired rejects the clients without a certificate signed by the platform CA
+	TLSClientAuthRequired TLSClientAuth = "required"
+)
+
+// PlatformTLSSpec describes the HTTPS configuration of the workflows and the supporting services deployed in the
+// platform namespace. The certificate is read from a user Secret, or requested to cert-manager.
+// Workflows deployed with the "knative" deployment model are secured by Knative Serving instead.
+type PlatformTLSSpec struct {
+	// Enabled turns on HTTPS for the workflows, the Data Index and the Jobs Service, and switches the generated
+	// service URLs of the platform namespace to https.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretRef is the name of a kubernetes.io/tls Secret in the platform namespace, holding the "tls.crt", "tls.key"
+	// and "ca.crt" entries. The certificate must be valid for the workflow and service host names.
+	// +optional
+	SecretRef string `json:"secretRef,omitempty"`
+	// CertManager requests the certificate to cert-manager. Can't be used together with secretRef.
+	// +optional
+	CertManager *CertManagerTLSSpec `json:"certManager,omitempty"`
+	// ClientAuth enforces the client certificate authentication on the HTTPS endpoints. Defaults to "none".
+	// The workflows and the supporting services present the platform certificate as client certificate.
+	// "required" is refused by the admission webhook: it disables the plain HTTP port the exposure backends, the Knative
+	// sinks and the consumers of other namespaces still use, and none of them presents a client certificate.
+	// +optional
+	ClientAuth TLSClientAuth `json:"clientAuth,omitempty"`
+}
+
+// CertManagerTLSSpec describes the cert-manager Certificate requested for the platform namespace.
+type CertManagerTLSSpec struct {
+	// IssuerRef is the cert-manager issuer signing the certificate.
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+}
+
+// CertManagerIssuerReference points to a cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuerReference struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
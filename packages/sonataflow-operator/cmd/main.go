@@ -42,6 +42,7 @@ import (
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	webhookv1alpha08 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/webhook/v1alpha08"
 
 	"k8s.io/klog/v2"
 
@@ -53,13 +54,16 @@ import (
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	operatorapiv1beta1 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1beta1"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
 	//+kubebuilder:scaffold:imports
 )
@@ -71,6 +75,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(operatorapi.AddToScheme(scheme))
+	utilruntime.Must(operatorapiv1beta1.AddToScheme(scheme))
 	utilruntime.Must(sourcesv1.AddToScheme(scheme))
 	utilruntime.Must(eventingv1.AddToScheme(scheme))
 	utilruntime.Must(servingv1.AddToScheme(scheme))
@@ -89,6 +94,7 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var enableWebhooks bool
 	var controllerCfgPath string
 	klog.InitFlags(nil)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -113,6 +119,8 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the admission webhooks and the v1beta1 conversion webhook are served. Requires the webhook serving certificates to be mounted")
 	flag.StringVar(&controllerCfgPath, "controller-cfg-path", "", "The controller config file path.")
 	flag.Parse()
 
@@ -178,6 +186,11 @@ func main() {
 		LeaseDuration: leaseDuration,
 		RenewDeadline: renewDeadline,
 		RetryPeriod:   retryPeriod,
+		// Secrets are read straight from the API server, so the operator doesn't cache the content of every
+		// Secret in the cluster. The controllers only watch their metadata.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}}},
+		},
 	})
 	if err != nil {
 		klog.V(log.E).ErrorS(err, "unable to start manager")
@@ -242,6 +255,24 @@ func main() {
 		klog.V(log.E).ErrorS(err, "unable to create controller", "controller", "SonataFlowClusterPlatform")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err = webhookv1alpha08.SetupSonataFlowWebhookWithManager(mgr); err != nil {
+			klog.V(log.E).ErrorS(err, "unable to create webhook", "webhook", "SonataFlow")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha08.SetupSonataFlowBuildWebhookWithManager(mgr); err != nil {
+			klog.V(log.E).ErrorS(err, "unable to create webhook", "webhook", "SonataFlowBuild")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha08.SetupSonataFlowPlatformWebhookWithManager(mgr); err != nil {
+			klog.V(log.E).ErrorS(err, "unable to create webhook", "webhook", "SonataFlowPlatform")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha08.SetupSonataFlowClusterPlatformWebhookWithManager(mgr); err != nil {
+			klog.V(log.E).ErrorS(err, "unable to create webhook", "webhook", "SonataFlowClusterPlatform")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if utils.IsOpenShift() {
@@ -80,6 +80,10 @@ type ContainerRegistrySpec struct {
 type ContainerBuildTask struct {
 	// a KanikoTask, for Kaniko strategy
 	Kaniko *KanikoTask `json:"kaniko,omitempty"`
+	// a BuildahTask, for Buildah strategy
+	Buildah *BuildahTask `json:"buildah,omitempty"`
+	// a BuildKitTask, for BuildKit strategy
+	BuildKit *BuildKitTask `json:"buildKit,omitempty"`
 }
 
 // ContainerBuildBaseTask is a base for the struct hierarchy
@@ -129,6 +133,30 @@ type KanikoTask struct {
 	KanikoExecutorImage string `json:"kanikoExecutorImage,omitempty"`
 }
 
+// BuildahTask is used to configure rootless Buildah
+type BuildahTask struct {
+	ContainerBuildBaseTask `json:",inline"`
+	PublishTask            `json:",inline"`
+	// log more information
+	Verbose *bool `json:"verbose,omitempty"`
+	// AdditionalFlags -- List of additional flags for the `buildah bud` command (see https://github.com/containers/buildah/blob/main/docs/buildah-build.1.md)
+	AdditionalFlags []string `json:"additionalFlags,omitempty"`
+	// Image used by the created Buildah pod
+	BuildahImage string `json:"buildahImage,omitempty"`
+}
+
+// BuildKitTask is used to configure rootless BuildKit
+type BuildKitTask struct {
+	ContainerBuildBaseTask `json:",inline"`
+	PublishTask            `json:",inline"`
+	// log more information
+	Verbose *bool `json:"verbose,omitempty"`
+	// AdditionalFlags -- List of additional flags for the `buildctl build` command (see https://github.com/moby/buildkit/blob/master/README.md)
+	AdditionalFlags []string `json:"additionalFlags,omitempty"`
+	// Image used by the created BuildKit pod
+	BuildKitImage string `json:"buildKitImage,omitempty"`
+}
+
 // KanikoTaskCache is used to configure Kaniko cache
 type KanikoTaskCache struct {
 	// true if a cache is enabled
@@ -194,6 +222,8 @@ type ContainerBuildStatus struct {
 	BaseImage string `json:"baseImage,omitempty"`
 	// the error description (if any)
 	Error string `json:"error,omitempty"`
+	// the tail of the builder logs, captured when the build fails
+	Logs string `json:"logs,omitempty"`
 	// the reason of the failure (if any)
 	Failure *ContainerBuildFailure `json:"failure,omitempty"`
 	// the time when it started
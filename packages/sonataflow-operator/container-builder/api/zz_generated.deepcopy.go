@@ -28,6 +28,60 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildKitTask) DeepCopyInto(out *BuildKitTask) {
+	*out = *in
+	in.ContainerBuildBaseTask.DeepCopyInto(&out.ContainerBuildBaseTask)
+	out.PublishTask = in.PublishTask
+	if in.Verbose != nil {
+		in, out := &in.Verbose, &out.Verbose
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalFlags != nil {
+		in, out := &in.AdditionalFlags, &out.AdditionalFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildKitTask.
+func (in *BuildKitTask) DeepCopy() *BuildKitTask {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildKitTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildahTask) DeepCopyInto(out *BuildahTask) {
+	*out = *in
+	in.ContainerBuildBaseTask.DeepCopyInto(&out.ContainerBuildBaseTask)
+	out.PublishTask = in.PublishTask
+	if in.Verbose != nil {
+		in, out := &in.Verbose, &out.Verbose
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalFlags != nil {
+		in, out := &in.AdditionalFlags, &out.AdditionalFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildahTask.
+func (in *BuildahTask) DeepCopy() *BuildahTask {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildahTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerBuild) DeepCopyInto(out *ContainerBuild) {
 	*out = *in
@@ -208,6 +262,16 @@ func (in *ContainerBuildTask) DeepCopyInto(out *ContainerBuildTask) {
 		*out = new(KanikoTask)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Buildah != nil {
+		in, out := &in.Buildah, &out.Buildah
+		*out = new(BuildahTask)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildKit != nil {
+		in, out := &in.BuildKit, &out.BuildKit
+		*out = new(BuildKitTask)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerBuildTask.
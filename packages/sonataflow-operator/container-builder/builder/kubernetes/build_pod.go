@@ -30,6 +30,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util/minikube"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util/registry"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -70,6 +72,16 @@ func newBuildPod(ctx context.Context, c client.Client, build *api.ContainerBuild
 			if err != nil {
 				return nil, err
 			}
+		case task.Buildah != nil:
+			err := addBuildahTaskToPod(ctx, c, build, task.Buildah, pod)
+			if err != nil {
+				return nil, err
+			}
+		case task.BuildKit != nil:
+			err := addBuildKitTaskToPod(ctx, c, build, task.BuildKit, pod)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -113,6 +125,27 @@ func deleteBuilderPod(ctx context.Context, c client.Client, build *api.Container
 	return err
 }
 
+// lookupRegistryAddress sets the address of the registry discovered in the environment when none is defined.
+func lookupRegistryAddress(ctx context.Context, c client.Client, reg *api.ContainerRegistrySpec) error {
+	// TODO: perform an actual registry lookup based on the environment
+	if reg.Address != "" {
+		return nil
+	}
+	address, err := registry.GetRegistryAddress(ctx, c)
+	if err != nil {
+		return err
+	}
+	if address == nil {
+		if address, err = minikube.FindRegistry(ctx, c); err != nil {
+			return err
+		}
+	}
+	if address != nil {
+		reg.Address = *address
+	}
+	return nil
+}
+
 func getRegistrySecret(ctx context.Context, c client.Client, ns, name string, registrySecrets []registrySecret) (registrySecret, error) {
 	secret := corev1.Secret{}
 	err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns}, &secret)
@@ -183,3 +216,61 @@ func proxyFromEnvironment() []corev1.EnvVar {
 
 	return envVars
 }
+
+// rootlessBuilderPod is the pod setup shared by the rootless builders: the builder storage, the registry credentials,
+// the build context volume, the proxy settings and the resolved build arguments.
+type rootlessBuilderPod struct {
+	env          []corev1.EnvVar
+	volumes      []corev1.Volume
+	volumeMounts []corev1.VolumeMount
+	buildArgs    []string
+}
+
+func newRootlessBuilderPod(ctx context.Context, c client.Client, build *api.ContainerBuild, pod *corev1.Pod,
+	base *api.ContainerBuildBaseTask, publish *api.PublishTask, storage corev1.VolumeMount, registrySecrets []registrySecret, env ...corev1.EnvVar) (*rootlessBuilderPod, error) {
+	if err := lookupRegistryAddress(ctx, c, &publish.Registry); err != nil {
+		return nil, err
+	}
+
+	builderPod := &rootlessBuilderPod{
+		env: append(append(make([]corev1.EnvVar, 0, len(env)+len(base.Envs)), env...), base.Envs...),
+		volumes: []corev1.Volume{{
+			Name:         storage.Name,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}},
+		volumeMounts: []corev1.VolumeMount{storage},
+	}
+
+	if publish.Registry.Secret != "" {
+		secret, err := getRegistrySecret(ctx, c, pod.Namespace, publish.Registry.Secret, registrySecrets)
+		if err != nil {
+			return nil, err
+		}
+		addRegistrySecret(publish.Registry.Secret, secret, &builderPod.volumes, &builderPod.volumeMounts, &builderPod.env)
+	}
+
+	// TODO: should be handled by a mount build context handler instead since we can have many possibilities
+	if err := addResourcesToBuilderContextVolume(ctx, c, *publish, build, &builderPod.volumes, &builderPod.volumeMounts); err != nil {
+		return nil, err
+	}
+
+	builderPod.env = append(builderPod.env, proxyFromEnvironment()...)
+
+	buildArgs, err := FromEnvToArgs(c, pod.Namespace, base.BuildArgs...)
+	if err != nil {
+		return nil, err
+	}
+	builderPod.buildArgs = buildArgs
+
+	return builderPod, nil
+}
+
+// addContainer adds the builder container with the shared environment and volumes to the pod.
+func (b *rootlessBuilderPod) addContainer(pod *corev1.Pod, container corev1.Container) {
+	container.ImagePullPolicy = corev1.PullIfNotPresent
+	container.Env = b.env
+	container.VolumeMounts = b.volumeMounts
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, b.volumes...)
+	pod.Spec.Containers = append(pod.Spec.Containers, container)
+}
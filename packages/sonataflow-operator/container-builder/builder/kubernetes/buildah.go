@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util"
+)
+
+const (
+	defaultBuildahImage = "quay.io/buildah/stable:v1.37"
+	// buildahStoragePath is the containers storage of the rootless `build` user of the Buildah image
+	buildahStoragePath = "/home/build/.local/share/containers"
+	// buildahUser is the uid of the rootless `build` user of the Buildah image
+	buildahUser = int64(1000)
+)
+
+var (
+	plainDockerBuildahRegistrySecret = registrySecret{
+		fileName:    "config.json",
+		mountPath:   "/buildah/.docker",
+		destination: "config.json",
+		refEnv:      "REGISTRY_AUTH_FILE",
+	}
+	standardDockerBuildahRegistrySecret = registrySecret{
+		fileName:    corev1.DockerConfigJsonKey,
+		mountPath:   "/buildah/.docker",
+		destination: "config.json",
+		refEnv:      "REGISTRY_AUTH_FILE",
+	}
+
+	buildahRegistrySecrets = []registrySecret{
+		plainDockerBuildahRegistrySecret,
+		standardDockerBuildahRegistrySecret,
+	}
+)
+
+// see: https://github.com/containers/buildah/blob/main/docs/buildah-build.1.md
+const buildahBuildArgs = "--build-arg"
+
+func addBuildahTaskToPod(ctx context.Context, c client.Client, build *api.ContainerBuild, task *api.BuildahTask, pod *corev1.Pod) error {
+	builderPod, err := newRootlessBuilderPod(ctx, c, build, pod, &task.ContainerBuildBaseTask, &task.PublishTask,
+		corev1.VolumeMount{Name: "buildah-storage", MountPath: buildahStoragePath}, buildahRegistrySecrets)
+	if err != nil {
+		return err
+	}
+
+	// the image is built with `buildah bud` in the rootless storage, then pushed with `buildah push`
+	// the image tag and the bud arguments are given to the script as positional parameters, so they're never parsed by the shell
+	args := []string{
+		task.GetRepositoryImageTag(),
+		"--storage-driver=vfs",
+		"--isolation=chroot",
+		"--file=Dockerfile",
+		"--tag=" + task.GetRepositoryImageTag(),
+	}
+	pushArgs := []string{"--storage-driver=vfs"}
+	globalArgs := ""
+	if task.Verbose != nil && *task.Verbose {
+		globalArgs = " --log-level=debug"
+	}
+
+	if task.Registry.Insecure {
+		args = append(args, "--tls-verify=false")
+		pushArgs = append(pushArgs, "--tls-verify=false")
+	}
+
+	for _, buildArg := range builderPod.buildArgs {
+		args = append(args, fmt.Sprintf("%s=%s", buildahBuildArgs, buildArg))
+	}
+
+	if len(task.AdditionalFlags) > 0 {
+		args = append(args, task.AdditionalFlags...)
+	}
+	args = append(args, task.ContextDir)
+
+	script := fmt.Sprintf(`tag="$1"; shift; buildah%s bud "$@" && buildah%s push %s "$tag"`,
+		globalArgs, globalArgs, strings.Join(pushArgs, " "))
+
+	builderPod.addContainer(pod, corev1.Container{
+		Name:            strings.ToLower(task.Name),
+		Image:           task.BuildahImage,
+		Command:         []string{"/bin/sh", "-c", script, "buildah"},
+		Args:            args,
+		WorkingDir:      task.ContextDir,
+		Resources:       task.Resources,
+		SecurityContext: BuildahSecurityDefaults(),
+	})
+
+	return nil
+}
+
+// BuildahSecurityDefaults runs Buildah as the rootless `build` user, only keeping the capabilities needed to map
+// the users of the built image.
+func BuildahSecurityDefaults() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: util.Pbool(false),
+		Privileged:               util.Pbool(false),
+		RunAsUser:                util.Pint64(buildahUser),
+		RunAsNonRoot:             util.Pbool(true),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+			Add:  []corev1.Capability{"SETUID", "SETGID"},
+		},
+	}
+}
@@ -40,7 +40,8 @@ type ContainerBuilderInfo struct {
 	FinalImageName  string
 	BuildUniqueName string
 	Platform        api.PlatformContainerBuild
-	// ContainerBuilderImageTag the image tag used internally to create the pod builder (e.g. Kaniko Executor Builder image)
+	// ContainerBuilderImageTag the image tag used internally to create the pod builder (e.g. Kaniko Executor Builder image).
+	// Buildah and BuildKit default to their upstream rootless images when it's empty.
 	ContainerBuilderImageTag string
 }
 
@@ -81,7 +82,9 @@ var _ MountHandler = &mountHandler{}
 
 // available schedulers, add them in priority order
 var schedulers = map[string]schedulerManager{
-	"kaniko": &kanikoSchedulerManager{},
+	"kaniko":   &kanikoSchedulerManager{},
+	"buildah":  &buildahSchedulerManager{},
+	"buildkit": &buildKitSchedulerManager{},
 }
 
 // Scheduler provides an interface to add resources and schedule a new build
@@ -0,0 +1,52 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+)
+
+type buildKitSchedulerManager struct {
+}
+
+var _ schedulerManager = &buildKitSchedulerManager{}
+
+func (b buildKitSchedulerManager) CreateScheduler(info ContainerBuilderInfo, ctx *containerBuildContext, hook schedulerHook) Scheduler {
+	image := info.ContainerBuilderImageTag
+	if len(image) == 0 {
+		image = defaultBuildKitImage
+	}
+	buildKitTask := api.BuildKitTask{
+		ContainerBuildBaseTask: api.ContainerBuildBaseTask{Name: "BuildKitTask"},
+		PublishTask:            newPublishTask(info),
+		BuildKitImage:          image,
+	}
+	newPodContainerBuild(info, ctx, api.ContainerBuildTask{BuildKit: &buildKitTask})
+
+	return &rootlessScheduler{
+		schedulerHook:   hook,
+		baseTask:        &buildKitTask.ContainerBuildBaseTask,
+		additionalFlags: &buildKitTask.AdditionalFlags,
+	}
+}
+
+func (b buildKitSchedulerManager) CanHandle(info ContainerBuilderInfo) bool {
+	return info.Platform.Spec.BuildStrategy == api.ContainerBuildStrategyPod && info.Platform.Spec.PublishStrategy == api.PlatformBuildPublishStrategyBuildKit
+}
@@ -20,8 +20,6 @@
 package kubernetes
 
 import (
-	"path"
-
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
@@ -44,26 +42,12 @@ var _ schedulerManager = &kanikoSchedulerManager{}
 func (k kanikoSchedulerManager) CreateScheduler(info ContainerBuilderInfo, ctx *containerBuildContext, hook schedulerHook) Scheduler {
 	kanikoTask := api.KanikoTask{
 		ContainerBuildBaseTask: api.ContainerBuildBaseTask{Name: "KanikoTask"},
-		PublishTask: api.PublishTask{
-			ContextDir: path.Join("/builder", info.BuildUniqueName, "context"),
-			BaseImage:  info.Platform.Spec.BaseImage,
-			Image:      info.FinalImageName,
-			Registry:   info.Platform.Spec.Registry,
-		},
-		Cache:               api.KanikoTaskCache{},
-		KanikoExecutorImage: info.ContainerBuilderImageTag,
+		PublishTask:            newPublishTask(info),
+		Cache:                  api.KanikoTaskCache{},
+		KanikoExecutorImage:    info.ContainerBuilderImageTag,
 	}
 
-	ctx.containerBuild = &api.ContainerBuild{
-		Spec: api.ContainerBuildSpec{
-			Tasks:    []api.ContainerBuildTask{{Kaniko: &kanikoTask}},
-			Strategy: api.ContainerBuildStrategyPod,
-			Timeout:  *info.Platform.Spec.Timeout,
-		},
-		Status: api.ContainerBuildStatus{},
-	}
-	ctx.containerBuild.Name = info.BuildUniqueName
-	ctx.containerBuild.Namespace = info.Platform.Namespace
+	newPodContainerBuild(info, ctx, api.ContainerBuildTask{Kaniko: &kanikoTask})
 
 	sched := &kanikoScheduler{
 		schedulerHook: hook,
@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+)
+
+// newPublishTask creates the image publish configuration of the builder tasks.
+func newPublishTask(info ContainerBuilderInfo) api.PublishTask {
+	return api.PublishTask{
+		ContextDir: path.Join("/builder", info.BuildUniqueName, "context"),
+		BaseImage:  info.Platform.Spec.BaseImage,
+		Image:      info.FinalImageName,
+		Registry:   info.Platform.Spec.Registry,
+	}
+}
+
+// newPodContainerBuild sets the ContainerBuild running the given task in a builder pod to the build context.
+func newPodContainerBuild(info ContainerBuilderInfo, ctx *containerBuildContext, task api.ContainerBuildTask) {
+	ctx.containerBuild = &api.ContainerBuild{
+		Spec: api.ContainerBuildSpec{
+			Tasks:    []api.ContainerBuildTask{task},
+			Strategy: api.ContainerBuildStrategyPod,
+			Timeout:  *info.Platform.Spec.Timeout,
+		},
+		Status: api.ContainerBuildStatus{},
+	}
+	ctx.containerBuild.Name = info.BuildUniqueName
+	ctx.containerBuild.Namespace = info.Platform.Namespace
+}
+
+var _ Scheduler = &rootlessScheduler{}
+
+// rootlessScheduler schedules the rootless builder tasks, which don't know any specialized property.
+type rootlessScheduler struct {
+	schedulerHook   schedulerHook
+	baseTask        *api.ContainerBuildBaseTask
+	additionalFlags *[]string
+}
+
+// WithProperty no specialized properties are known by the rootless builders yet, the Kaniko cache doesn't apply.
+func (sr *rootlessScheduler) WithProperty(property BuilderProperty, object interface{}) Scheduler {
+	return sr
+}
+
+func (sr *rootlessScheduler) WithResourceRequirements(res corev1.ResourceRequirements) Scheduler {
+	sr.baseTask.Resources = res
+	return sr
+}
+
+func (sr *rootlessScheduler) WithAdditionalArgs(flags []string) Scheduler {
+	*sr.additionalFlags = flags
+	return sr
+}
+
+func (sr *rootlessScheduler) WithBuildArgs(args []corev1.EnvVar) Scheduler {
+	sr.baseTask.BuildArgs = args
+	return sr
+}
+
+func (sr *rootlessScheduler) WithEnvs(envs []corev1.EnvVar) Scheduler {
+	sr.baseTask.Envs = envs
+	return sr
+}
+
+func (sr *rootlessScheduler) Schedule() (*api.ContainerBuild, error) {
+	return sr.schedulerHook()
+}
@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util/test"
+)
+
+func TestNewBuildWithRootlessBuilders(t *testing.T) {
+	tests := []struct {
+		name            string
+		publishStrategy api.PlatformContainerBuildPublishStrategy
+		additionalArgs  []string
+		expectedImage   string
+		expectedArgs    []string
+		verify          func(t *testing.T, build *api.ContainerBuild, container v1.Container)
+	}{
+		{
+			name:            "Buildah",
+			publishStrategy: api.PlatformBuildPublishStrategyBuildah,
+			additionalArgs:  []string{"--layers"},
+			expectedImage:   defaultBuildahImage,
+			expectedArgs:    []string{"--tag=registry:5000/namespace/service:latest", "--tls-verify=false", "--build-arg=QUARKUS_EXTENSIONS=extension1,extension2", "--layers"},
+			verify: func(t *testing.T, build *api.ContainerBuild, container v1.Container) {
+				assert.NotNil(t, build.Spec.Tasks[0].Buildah)
+				assert.Equal(t, defaultBuildahImage, build.Spec.Tasks[0].Buildah.BuildahImage)
+				// the image tag is only given to the push as a positional parameter
+				assert.Equal(t, "registry:5000/namespace/service:latest", container.Args[0])
+				assert.NotContains(t, container.Command[2], "registry:5000")
+				assert.Contains(t, container.Command[2], `"$tag"`)
+			},
+		},
+		{
+			name:            "BuildKit",
+			publishStrategy: api.PlatformBuildPublishStrategyBuildKit,
+			additionalArgs:  []string{"--no-cache"},
+			expectedImage:   defaultBuildKitImage,
+			expectedArgs:    []string{"build", "--output=type=image,name=registry:5000/namespace/service:latest,push=true,registry.insecure=true", "--opt=build-arg:QUARKUS_EXTENSIONS=extension1,extension2", "--no-cache"},
+			verify: func(t *testing.T, build *api.ContainerBuild, container v1.Container) {
+				assert.NotNil(t, build.Spec.Tasks[0].BuildKit)
+				assert.Equal(t, defaultBuildKitImage, build.Spec.Tasks[0].BuildKit.BuildKitImage)
+				assert.Subset(t, container.Env, []v1.EnvVar{{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"}})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := "test"
+			c := test.NewFakeClient()
+
+			dockerFile, err := os.ReadFile("testdata/sample.Dockerfile")
+			assert.NoError(t, err)
+
+			workflowDefinition, err := os.ReadFile("testdata/greetings.sw.json")
+			assert.NoError(t, err)
+
+			platform := api.PlatformContainerBuild{
+				ObjectReference: api.ObjectReference{
+					Namespace: ns,
+					Name:      "testPlatform",
+				},
+				Spec: api.PlatformContainerBuildSpec{
+					BuildStrategy:   api.ContainerBuildStrategyPod,
+					PublishStrategy: tt.publishStrategy,
+					Registry:        api.ContainerRegistrySpec{Address: "registry:5000", Insecure: true},
+					Timeout:         &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			}
+
+			build, err := NewBuild(ContainerBuilderInfo{FinalImageName: "namespace/service:latest", BuildUniqueName: "build1", Platform: platform}).
+				AddResource("Dockerfile", dockerFile).
+				AddResource("greetings.sw.json", workflowDefinition).
+				WithClient(c).
+				Scheduler().
+				WithBuildArgs([]v1.EnvVar{{
+					Name:  "QUARKUS_EXTENSIONS",
+					Value: "extension1,extension2",
+				}}).
+				WithEnvs([]v1.EnvVar{{
+					Name:  "MYENV",
+					Value: "value",
+				}}).
+				WithAdditionalArgs(tt.additionalArgs).
+				Schedule()
+			assert.NoError(t, err)
+
+			// reconcile twice to push forward to the pod creation
+			reconciled, err := FromBuild(build).WithClient(c).Reconcile()
+			assert.NoError(t, err)
+			assert.NotNil(t, reconciled)
+			reconciled, err = FromBuild(reconciled).WithClient(c).Reconcile()
+			assert.NoError(t, err)
+			assert.NotNil(t, reconciled)
+
+			pod := &v1.Pod{}
+			err = c.Get(context.TODO(), types.NamespacedName{Name: buildPodName(reconciled), Namespace: ns}, pod)
+			assert.NoError(t, err)
+			assert.Len(t, pod.Spec.Containers, 1)
+
+			container := pod.Spec.Containers[0]
+			assert.Equal(t, tt.expectedImage, container.Image)
+			assert.Subset(t, container.Args, tt.expectedArgs)
+			assert.Subset(t, container.Env, []v1.EnvVar{{Name: "MYENV", Value: "value"}})
+			assert.True(t, *container.SecurityContext.RunAsNonRoot)
+			tt.verify(t, build, container)
+		})
+	}
+}
@@ -22,8 +22,10 @@ package kubernetes
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -85,3 +87,40 @@ func TestNewBuild(t *testing.T) {
 	assert.NotNil(t, pod)
 	assert.Len(t, pod.Spec.Volumes, 1)
 }
+
+func TestMonitorPodFailedBuildLogs(t *testing.T) {
+	ns := "test"
+	build := &api.ContainerBuild{
+		ObjectReference: api.ObjectReference{Name: "build1", Namespace: ns},
+		Spec:            api.ContainerBuildSpec{Timeout: metav1.Duration{Duration: 5 * time.Minute}},
+		Status:          api.ContainerBuildStatus{Phase: api.ContainerBuildPhaseRunning, StartedAt: &metav1.Time{Time: time.Now()}},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: buildPodName(build), Namespace: ns},
+		Status: v1.PodStatus{
+			Phase: v1.PodFailed,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "builder", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Message: "build failed"}}},
+			},
+		},
+	}
+	action := newMonitorPodAction()
+	action.InjectClient(test.NewFakeClient(pod))
+
+	build, err := action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ContainerBuildPhaseFailed, build.Status.Phase)
+	assert.Equal(t, "build failed", build.Status.Error)
+	// the fake clientset always answers with the same logs
+	assert.Equal(t, "fake logs", build.Status.Logs)
+}
+
+func TestTrimLogs(t *testing.T) {
+	assert.Equal(t, "short logs", TrimLogs("short logs"))
+
+	// the cut falls in the middle of the two bytes "é", which is dropped instead of split
+	logs := strings.Repeat("a", 10) + "é" + strings.Repeat("b", LogsLimitBytes-1)
+	trimmed := TrimLogs(logs)
+	assert.True(t, utf8.ValidString(trimmed))
+	assert.Equal(t, strings.Repeat("b", LogsLimitBytes-1), trimmed)
+}
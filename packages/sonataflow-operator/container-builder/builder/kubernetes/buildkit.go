@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util"
+)
+
+const (
+	defaultBuildKitImage = "docker.io/moby/buildkit:v0.16.0-rootless"
+	// buildKitStatePath is the state directory of the rootless `user` of the BuildKit image
+	buildKitStatePath = "/home/user/.local/share/buildkit"
+	// buildKitUser is the uid of the rootless `user` of the BuildKit image
+	buildKitUser = int64(1000)
+)
+
+var (
+	plainDockerBuildKitRegistrySecret = registrySecret{
+		fileName:    "config.json",
+		mountPath:   "/home/user/.docker",
+		destination: "config.json",
+	}
+	standardDockerBuildKitRegistrySecret = registrySecret{
+		fileName:    corev1.DockerConfigJsonKey,
+		mountPath:   "/home/user/.docker",
+		destination: "config.json",
+	}
+
+	buildKitRegistrySecrets = []registrySecret{
+		plainDockerBuildKitRegistrySecret,
+		standardDockerBuildKitRegistrySecret,
+	}
+)
+
+// see: https://github.com/moby/buildkit/blob/master/frontend/dockerfile/docs/reference.md#arg
+const buildKitBuildArgs = "--opt=build-arg:"
+
+func addBuildKitTaskToPod(ctx context.Context, c client.Client, build *api.ContainerBuild, task *api.BuildKitTask, pod *corev1.Pod) error {
+	builderPod, err := newRootlessBuilderPod(ctx, c, build, pod, &task.ContainerBuildBaseTask, &task.PublishTask,
+		corev1.VolumeMount{Name: "buildkit-state", MountPath: buildKitStatePath}, buildKitRegistrySecrets,
+		// the pod can't create the nested process sandbox without being privileged
+		corev1.EnvVar{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"})
+	if err != nil {
+		return err
+	}
+
+	output := "--output=type=image,name=" + task.GetRepositoryImageTag() + ",push=true"
+	if task.Registry.Insecure {
+		output += ",registry.insecure=true"
+	}
+	args := make([]string, 0)
+	if task.Verbose != nil && *task.Verbose {
+		args = append(args, "--debug")
+	}
+	// the daemonless script starts a rootless buildkitd for the lifetime of the build
+	args = append(args,
+		"build",
+		"--frontend=dockerfile.v0",
+		"--local=context="+task.ContextDir,
+		"--local=dockerfile="+task.ContextDir,
+		output,
+	)
+
+	for _, buildArg := range builderPod.buildArgs {
+		args = append(args, fmt.Sprintf("%s%s", buildKitBuildArgs, buildArg))
+	}
+
+	if len(task.AdditionalFlags) > 0 {
+		args = append(args, task.AdditionalFlags...)
+	}
+
+	builderPod.addContainer(pod, corev1.Container{
+		Name:            strings.ToLower(task.Name),
+		Image:           task.BuildKitImage,
+		Command:         []string{"buildctl-daemonless.sh"},
+		Args:            args,
+		WorkingDir:      task.ContextDir,
+		Resources:       task.Resources,
+		SecurityContext: BuildKitSecurityDefaults(),
+	})
+
+	return nil
+}
+
+// BuildKitSecurityDefaults runs BuildKit as the rootless `user`. Rootless BuildKit needs to create its own mount and
+// user namespaces, which the default seccomp and AppArmor profiles forbid.
+func BuildKitSecurityDefaults() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		Privileged:   util.Pbool(false),
+		RunAsUser:    util.Pint64(buildKitUser),
+		RunAsGroup:   util.Pint64(buildKitUser),
+		RunAsNonRoot: util.Pbool(true),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeUnconfined,
+		},
+		AppArmorProfile: &corev1.AppArmorProfile{
+			Type: corev1.AppArmorProfileTypeUnconfined,
+		},
+	}
+}
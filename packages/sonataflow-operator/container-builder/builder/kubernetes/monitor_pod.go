@@ -23,19 +23,30 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/util/log"
 )
 
-const timeoutAnnotation = "sonataflow.org/timeout"
+const (
+	timeoutAnnotation = "sonataflow.org/timeout"
+	// logsTailLines is the number of lines kept from the logs of the failed builder containers
+	logsTailLines int64 = 50
+	// LogsLimitBytes bounds the size of the builder logs kept in the build status
+	LogsLimitBytes = 8 * 1024
+)
 
 func newMonitorPodAction() Action {
 	return &monitorPodAction{}
@@ -113,6 +124,14 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *api.Container
 				build.Status.RepositoryImageTag = t.GetRepositoryImageTag()
 				break
 			}
+			if t := task.Buildah; t != nil {
+				build.Status.RepositoryImageTag = t.GetRepositoryImageTag()
+				break
+			}
+			if t := task.BuildKit; t != nil {
+				build.Status.RepositoryImageTag = t.GetRepositoryImageTag()
+				break
+			}
 		}
 
 	case corev1.PodFailed:
@@ -133,6 +152,7 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *api.Container
 		}
 		build.Status.Phase = phase
 		build.Status.Error = message
+		build.Status.Logs = GetFailedContainersLogs(ctx, action.client, pod)
 		finishedAt := action.getTerminatedTime(pod)
 		duration := finishedAt.Sub(build.Status.StartedAt.Time)
 		build.Status.Duration = duration.String()
@@ -277,6 +297,47 @@ func (action *monitorPodAction) getTerminationMessage(pod *corev1.Pod) string {
 	}
 }
 
+// GetFailedContainersLogs fetches the last lines of the failed containers logs of the pod, bounded to LogsLimitBytes.
+func GetFailedContainersLogs(ctx context.Context, c kubernetes.Interface, pod *corev1.Pod) string {
+	var logs []string
+
+	var containers []corev1.ContainerStatus
+	containers = append(containers, pod.Status.InitContainerStatuses...)
+	containers = append(containers, pod.Status.ContainerStatuses...)
+
+	tailLines := logsTailLines
+	limitBytes := int64(LogsLimitBytes)
+	for _, container := range containers {
+		if t := container.State.Terminated; t == nil || t.ExitCode == 0 {
+			continue
+		}
+		raw, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container:  container.Name,
+			TailLines:  &tailLines,
+			LimitBytes: &limitBytes,
+		}).DoRaw(ctx)
+		if err != nil {
+			klog.V(log.E).ErrorS(err, "Failed to fetch the builder container logs", "pod", pod.Name, "container", container.Name)
+			continue
+		}
+		logs = append(logs, string(raw))
+	}
+
+	return TrimLogs(strings.Join(logs, "\n"))
+}
+
+// TrimLogs keeps the end of the logs within LogsLimitBytes, without splitting a multibyte character.
+func TrimLogs(logs string) string {
+	if len(logs) <= LogsLimitBytes {
+		return logs
+	}
+	logs = logs[len(logs)-LogsLimitBytes:]
+	for len(logs) > 0 && !utf8.RuneStart(logs[0]) {
+		logs = logs[1:]
+	}
+	return logs
+}
+
 type terminationMessage struct {
 	Container string `json:"container,omitempty"`
 	Message   string `json:"message,omitempty"`
@@ -36,3 +36,7 @@ func Pbool(value bool) *bool {
 func Pint(value int) *int {
 	return &value
 }
+
+func Pint64(value int64) *int64 {
+	return &value
+}
@@ -0,0 +1,197 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+const (
+	// buildCacheConfigMapName is the index of the images built in a namespace, keyed by their content digest.
+	buildCacheConfigMapName = "sonataflow-build-cache"
+	// contentDigestTagLength is the length of the content digest prefix tagging the cached images.
+	contentDigestTagLength = 12
+)
+
+var _ BuildManager = &cachingBuildManager{}
+
+// cachedImage is an entry of the build cache index, the signing status is restored on the builds reusing the image.
+type cachedImage struct {
+	ImageTag string                          `json:"imageTag"`
+	Signing  *operatorapi.ImageSigningStatus `json:"signing,omitempty"`
+}
+
+// cachingBuildManager skips the builds of the workflow contents already built in the namespace, and reuses their images.
+// The contents are identified by a digest computed when the build is scheduled, the images of the succeeded builds are
+// recorded in the namespace build cache index. The reused images that can't be pulled anymore are evicted from the
+// index when the workflow is deployed, see EvictReusedImage.
+type cachingBuildManager struct {
+	buildManagerContext
+	manager BuildManager
+}
+
+func newCachingBuildManager(managerContext buildManagerContext, manager BuildManager) BuildManager {
+	return &cachingBuildManager{buildManagerContext: managerContext, manager: manager}
+}
+
+func (c *cachingBuildManager) Schedule(build *operatorapi.SonataFlowBuild) error {
+	workflow, err := c.fetchWorkflowForBuild(build)
+	if err != nil {
+		return err
+	}
+	digest, err := c.computeContentDigest(build, workflow)
+	if err != nil {
+		return err
+	}
+	image, err := c.lookupImage(build.Namespace, digest)
+	if err != nil {
+		return err
+	}
+	build.Status.ContentDigest = digest
+	// the images built before the platform enabled the signing are built again, so they're signed
+	if image != nil && (c.platform.Spec.Build.Config.Signing == nil || image.Signing != nil) {
+		klog.V(log.I).InfoS("Reusing the image built from the same workflow contents", "workflow", workflow.Name, "image", image.ImageTag)
+		build.Status.BuildPhase = operatorapi.BuildPhaseSucceeded
+		build.Status.ImageTag = image.ImageTag
+		build.Status.Error = ""
+		build.Status.Logs = ""
+		build.Status.Reused = true
+		build.Status.Signing = image.Signing
+		return nil
+	}
+	build.Status.Reused = false
+	return c.manager.Schedule(build)
+}
+
+func (c *cachingBuildManager) Reconcile(build *operatorapi.SonataFlowBuild) error {
+	if err := c.manager.Reconcile(build); err != nil {
+		return err
+	}
+	if build.Status.BuildPhase == operatorapi.BuildPhaseSucceeded && !build.Status.Reused &&
+		len(build.Status.ContentDigest) > 0 && len(build.Status.ImageTag) > 0 {
+		return c.storeImage(build.Namespace, build.Status.ContentDigest, cachedImage{ImageTag: build.Status.ImageTag, Signing: build.Status.Signing})
+	}
+	return nil
+}
+
+// computeContentDigest extends what the workflow FlowCRC covers with everything else ending up in the image:
+// the resources and properties ConfigMaps, the Containerfile and the build arguments.
+func (c *cachingBuildManager) computeContentDigest(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) (string, error) {
+	workflowResources := append([]operatorapi.ConfigMapWorkflowResource{}, workflow.Spec.Resources.ConfigMaps...)
+	workflowResources = append(workflowResources, buildWorkflowPropertyResources(workflow)...)
+	resources := map[string]interface{}{}
+	for _, res := range workflowResources {
+		configMap := &corev1.ConfigMap{}
+		if err := c.client.Get(c.ctx, types.NamespacedName{Name: res.ConfigMap.Name, Namespace: workflow.Namespace}, configMap); err != nil {
+			if errors.IsNotFound(err) {
+				// a missing ConfigMap is recorded too, so the digest changes once it's created
+				resources[path.Join(res.WorkflowPath, res.ConfigMap.Name)] = nil
+				continue
+			}
+			return "", err
+		}
+		resources[path.Join(res.WorkflowPath, res.ConfigMap.Name)] = map[string]interface{}{
+			"data":       configMap.Data,
+			"binaryData": configMap.BinaryData,
+		}
+	}
+	contents, err := json.Marshal(map[string]interface{}{
+		"flow":       workflow.Spec.Flow,
+		"resources":  resources,
+		"dockerfile": platform.GetCustomizedBuilderDockerfile(c.builderConfigMap.Data[defaultBuilderResourceName], *c.platform),
+		"arguments":  build.Spec.Arguments,
+		"buildArgs":  build.Spec.BuildArgs,
+		"envs":       build.Spec.Envs,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *cachingBuildManager) lookupImage(namespace, digest string) (*cachedImage, error) {
+	index := &corev1.ConfigMap{}
+	if err := c.client.Get(c.ctx, types.NamespacedName{Name: buildCacheConfigMapName, Namespace: namespace}, index); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entry, ok := index.Data[digest]
+	if !ok {
+		return nil, nil
+	}
+	image := &cachedImage{}
+	if err := json.Unmarshal([]byte(entry), image); err != nil || len(image.ImageTag) == 0 {
+		klog.V(log.I).InfoS("Ignoring the unreadable build cache entry", "namespace", namespace, "digest", digest)
+		return nil, nil
+	}
+	return image, nil
+}
+
+func (c *cachingBuildManager) storeImage(namespace, digest string, image cachedImage) error {
+	entry, err := json.Marshal(image)
+	if err != nil {
+		return err
+	}
+	index := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: buildCacheConfigMapName, Namespace: namespace}}
+	_, err = controllerutil.CreateOrPatch(c.ctx, c.client, index, func() error {
+		if index.Data == nil {
+			index.Data = map[string]string{}
+		}
+		index.Data[digest] = string(entry)
+		return nil
+	})
+	return err
+}
+
+// evictCachedImage removes the image built from the given contents digest from the namespace build cache index.
+func evictCachedImage(ctx context.Context, c client.Client, namespace, digest string) error {
+	index := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Name: buildCacheConfigMapName, Namespace: namespace}, index); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if _, ok := index.Data[digest]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(index.DeepCopy())
+	delete(index.Data, digest)
+	return c.Patch(ctx, index, patch)
+}
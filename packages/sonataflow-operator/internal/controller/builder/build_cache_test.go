@@ -0,0 +1,188 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+// fakeBuildManager builds every scheduled build successfully on the first reconciliation.
+type fakeBuildManager struct {
+	scheduled int
+	signing   *operatorapi.ImageSigningStatus
+}
+
+func (f *fakeBuildManager) Schedule(build *operatorapi.SonataFlowBuild) error {
+	f.scheduled++
+	build.Status.BuildPhase = operatorapi.BuildPhaseScheduling
+	return nil
+}
+
+func (f *fakeBuildManager) Reconcile(build *operatorapi.SonataFlowBuild) error {
+	build.Status.BuildPhase = operatorapi.BuildPhaseSucceeded
+	build.Status.Signing = f.signing
+	build.Status.ImageTag = "registry.local/" + build.Namespace + "/" + build.Name + ":latest"
+	if len(build.Status.ContentDigest) > 0 {
+		build.Status.ImageTag = "registry.local/" + build.Namespace + "/" + build.Name + ":" + build.Status.ContentDigest[:contentDigestTagLength]
+	}
+	return nil
+}
+
+func Test_cachingBuildManager(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	config := test.GetSonataFlowBuilderConfig(ns)
+	externalCm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "myopenapis", Namespace: ns},
+		Data:       map[string]string{"openapi.yaml": "openapi: 3.0.3"},
+	}
+	workflow.Spec.Resources.ConfigMaps = append(workflow.Spec.Resources.ConfigMaps,
+		operatorapi.ConfigMapWorkflowResource{ConfigMap: v1.LocalObjectReference{Name: externalCm.Name}, WorkflowPath: "specs"})
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, config, externalCm).Build()
+
+	managerContext := buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         platform,
+		builderConfigMap: config,
+	}
+	inner := &fakeBuildManager{}
+	buildManager := newCachingBuildManager(managerContext, inner)
+
+	// the first build of the contents is performed and recorded in the cache index
+	build, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 1, inner.scheduled)
+	assert.Len(t, build.Status.ContentDigest, 64)
+	assert.False(t, build.Status.Reused)
+	assert.NoError(t, buildManager.Reconcile(build))
+	imageTag := build.Status.ImageTag
+
+	index := &v1.ConfigMap{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: buildCacheConfigMapName}, index))
+	assert.JSONEq(t, `{"imageTag":"`+imageTag+`"}`, index.Data[build.Status.ContentDigest])
+
+	// the same contents are reused
+	build.Status = operatorapi.SonataFlowBuildStatus{}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 1, inner.scheduled)
+	assert.True(t, build.Status.Reused)
+	assert.Equal(t, operatorapi.BuildPhaseSucceeded, build.Status.BuildPhase)
+	assert.Equal(t, imageTag, build.Status.ImageTag)
+	digest := build.Status.ContentDigest
+
+	// changing a resource changes the contents
+	externalCm.Data["openapi.yaml"] = "openapi: 3.1.0"
+	assert.NoError(t, client.Update(context.TODO(), externalCm))
+	build.Status = operatorapi.SonataFlowBuildStatus{}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 2, inner.scheduled)
+	assert.False(t, build.Status.Reused)
+	assert.NotEqual(t, digest, build.Status.ContentDigest)
+	digest = build.Status.ContentDigest
+
+	// a missing resource is part of the contents too
+	assert.NoError(t, client.Delete(context.TODO(), externalCm))
+	build.Status = operatorapi.SonataFlowBuildStatus{}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 3, inner.scheduled)
+	assert.NotEqual(t, digest, build.Status.ContentDigest)
+}
+
+func Test_cachingBuildManager_Signing(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	config := test.GetSonataFlowBuilderConfig(ns)
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, config).Build()
+
+	inner := &fakeBuildManager{}
+	buildManager := newCachingBuildManager(buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         platform,
+		builderConfigMap: config,
+	}, inner)
+
+	build, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.NoError(t, buildManager.Reconcile(build))
+
+	// the image cached before the signing was enabled isn't signed, so it's built again
+	platform.Spec.Build.Config.Signing = &operatorapi.ImageSigningSpec{KeySecret: "cosign"}
+	inner.signing = &operatorapi.ImageSigningStatus{Digest: "sha256:0123"}
+	build.Status = operatorapi.SonataFlowBuildStatus{}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 2, inner.scheduled)
+	assert.NoError(t, buildManager.Reconcile(build))
+
+	// the signing status is restored with the reused image
+	build.Status = operatorapi.SonataFlowBuildStatus{}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 2, inner.scheduled)
+	assert.True(t, build.Status.Reused)
+	assert.Equal(t, inner.signing, build.Status.Signing)
+}
+
+func Test_sonataFlowBuildManager_EvictReusedImage(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	index := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: buildCacheConfigMapName, Namespace: ns},
+		Data:       map[string]string{"reused": "registry.local/reused:latest", "other": "registry.local/other:latest"},
+	}
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, index).
+		WithStatusSubresource(&operatorapi.SonataFlowBuild{}).Build()
+
+	buildManager := NewSonataFlowBuildManager(context.TODO(), client)
+	build, err := buildManager.GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	build.Status.BuildPhase = operatorapi.BuildPhaseSucceeded
+	build.Status.ContentDigest = "reused"
+	build.Status.Reused = true
+	assert.NoError(t, client.Status().Update(context.TODO(), build))
+
+	assert.NoError(t, buildManager.EvictReusedImage(build))
+	assert.Equal(t, operatorapi.BuildPhaseNone, build.Status.BuildPhase)
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: buildCacheConfigMapName}, index))
+	assert.Equal(t, map[string]string{"other": "registry.local/other:latest"}, index.Data)
+}
+
+func Test_buildNamespacedImageTagForBuild(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	build := &operatorapi.SonataFlowBuild{}
+	assert.Equal(t, buildNamespacedImageTag(workflow), buildNamespacedImageTagForBuild(workflow, build))
+
+	build.Status.ContentDigest = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	assert.Equal(t, t.Name()+"/"+workflow.Name+":0123456789ab", buildNamespacedImageTagForBuild(workflow, build))
+}
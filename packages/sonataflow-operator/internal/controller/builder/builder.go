@@ -66,6 +66,25 @@ func NewBuildManager(ctx context.Context, client client.Client, cliConfig *rest.
 		platform:         p,
 		builderConfigMap: builderConfig,
 	}
+	manager, err := newPlatformBuildManager(managerContext, cliConfig)
+	if err != nil {
+		return nil, err
+	}
+	if p.Spec.Build.Config.Signing != nil {
+		manager = newSigningBuildManager(managerContext, manager)
+	}
+	// the cache comes first, the reused images were signed when built
+	if platform.IsBuildCacheEnabled(p) {
+		return newCachingBuildManager(managerContext, manager), nil
+	}
+	return manager, nil
+}
+
+func newPlatformBuildManager(managerContext buildManagerContext, cliConfig *rest.Config) (BuildManager, error) {
+	p := managerContext.platform
+	if p.Spec.Build.Config.BuildStrategy == operatorapi.TektonBuildStrategy {
+		return newTektonBuilderManager(managerContext, cliConfig)
+	}
 	switch p.Status.Cluster {
 	case operatorapi.PlatformClusterOpenShift:
 		return newOpenShiftBuilderManager(managerContext, cliConfig)
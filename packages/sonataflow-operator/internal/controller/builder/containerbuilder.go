@@ -20,6 +20,8 @@
 package builder
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
@@ -60,6 +62,8 @@ type kanikoBuildInput struct {
 	workflowProperties []operatorapi.ConfigMapWorkflowResource
 	dockerfile         string
 	imageTag           string
+	// builderImage the image of the pod building the workflow image with the platform container builder
+	builderImage string
 }
 
 type containerBuilderManager struct {
@@ -101,6 +105,7 @@ func (c *containerBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) e
 		build.Status.BuildPhase = operatorapi.BuildPhaseInitialization
 	}
 	build.Status.Error = containerBuilder.Status.Error
+	build.Status.Logs = containerBuilder.Status.Logs
 	return nil
 }
 
@@ -116,6 +121,7 @@ func (c *containerBuilderManager) Reconcile(build *operatorapi.SonataFlowBuild)
 	}
 	build.Status.BuildPhase = operatorapi.BuildPhase(containerBuild.Status.Phase)
 	build.Status.Error = containerBuild.Status.Error
+	build.Status.Logs = containerBuild.Status.Logs
 	build.Status.ImageTag = containerBuild.Status.RepositoryImageTag
 	if err = build.Status.SetInnerBuild(containerBuild); err != nil {
 		return err
@@ -147,7 +153,8 @@ func (c *containerBuilderManager) scheduleNewKanikoBuildWithContainerFile(build
 		workflow:           workflow,
 		workflowProperties: buildWorkflowPropertyResources(workflow),
 		dockerfile:         platform.GetCustomizedBuilderDockerfile(c.builderConfigMap.Data[defaultBuilderResourceName], *c.platform),
-		imageTag:           buildNamespacedImageTag(workflow),
+		imageTag:           buildNamespacedImageTagForBuild(workflow, build),
+		builderImage:       platform.GetContainerBuilderImage(platform.GetContainerBuilder(c.platform)),
 	}
 
 	if c.platform.Spec.Build.Config.Timeout == nil {
@@ -163,6 +170,10 @@ func (c *containerBuilderManager) reconcileBuild(build *api.ContainerBuild, cli
 
 func (c *containerBuilderManager) buildImage(buildInput kanikoBuildInput) (*api.ContainerBuild, error) {
 	cli, err := client.FromCtrlClientSchemeAndConfig(c.client, c.client.Scheme(), c.restConfig)
+	containerBuilder := platform.GetContainerBuilder(c.platform)
+	if !slices.Contains(platform.SupportedContainerBuilders, containerBuilder) {
+		return nil, fmt.Errorf("container builder %s is not supported, use one of %v", containerBuilder, platform.SupportedContainerBuilders)
+	}
 	plat := api.PlatformContainerBuild{
 		ObjectReference: api.ObjectReference{
 			Namespace: c.platform.Namespace,
@@ -170,7 +181,7 @@ func (c *containerBuilderManager) buildImage(buildInput kanikoBuildInput) (*api.
 		},
 		Spec: api.PlatformContainerBuildSpec{
 			BuildStrategy:   api.ContainerBuildStrategyPod,
-			PublishStrategy: api.PlatformBuildPublishStrategyKaniko,
+			PublishStrategy: containerBuilder,
 			Registry: api.ContainerRegistrySpec{
 				Insecure: c.platform.Spec.Build.Config.Registry.Insecure,
 				Address:  c.platform.Spec.Build.Config.Registry.Address,
@@ -196,7 +207,7 @@ func newBuild(buildInput kanikoBuildInput, platform api.PlatformContainerBuild,
 		FinalImageName:           buildInput.imageTag,
 		BuildUniqueName:          buildInput.name,
 		Platform:                 platform,
-		ContainerBuilderImageTag: buildInput.task.KanikoExecutorImage,
+		ContainerBuilderImageTag: buildInput.builderImage,
 	}
 
 	newBuilder := builder.NewBuild(buildInfo).
@@ -226,6 +237,15 @@ func buildNamespacedImageTag(workflow *operatorapi.SonataFlow) string {
 	return workflow.Namespace + "/" + workflowdef.GetWorkflowAppImageNameTag(workflow)
 }
 
+// buildNamespacedImageTagForBuild tags the images of the cached builds with their content digest, so the image reused
+// by a later build is never overwritten by a build of different contents.
+func buildNamespacedImageTagForBuild(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) string {
+	if len(build.Status.ContentDigest) < contentDigestTagLength {
+		return buildNamespacedImageTag(workflow)
+	}
+	return workflow.Namespace + "/" + workflow.Name + ":" + build.Status.ContentDigest[:contentDigestTagLength]
+}
+
 func buildWorkflowPropertyResources(workflow *operatorapi.SonataFlow) []operatorapi.ConfigMapWorkflowResource {
 	return []operatorapi.ConfigMapWorkflowResource{
 		{ConfigMap: corev1.LocalObjectReference{Name: workflowproj.GetWorkflowUserPropertiesConfigMapName(workflow)}, WorkflowPath: ""},
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform"
@@ -51,6 +52,13 @@ func (k *sonataFlowBuildManager) MarkToRestart(build *operatorapi.SonataFlowBuil
 	return k.client.Status().Update(k.ctx, build)
 }
 
+func (k *sonataFlowBuildManager) EvictReusedImage(build *operatorapi.SonataFlowBuild) error {
+	if err := evictCachedImage(k.ctx, k.client, build.Namespace, build.Status.ContentDigest); err != nil {
+		return err
+	}
+	return k.MarkToRestart(build)
+}
+
 func (k *sonataFlowBuildManager) GetOrCreateBuild(workflow *operatorapi.SonataFlow) (*operatorapi.SonataFlowBuild, error) {
 	buildInstance := &operatorapi.SonataFlowBuild{}
 	buildInstance.ObjectMeta.Namespace = workflow.Namespace
@@ -63,8 +71,11 @@ func (k *sonataFlowBuildManager) GetOrCreateBuild(workflow *operatorapi.SonataFl
 				return nil, err
 			}
 			workflowBuildTemplate := plat.Spec.Build.Template.DeepCopy()
-			if persistence.UsesPostgreSQLPersistence(workflow, plat) {
-				addPersistenceExtensions(workflowBuildTemplate)
+			if p := persistence.ResolveWorkflowProvider(workflow, plat); p != nil {
+				addPersistenceExtensions(workflowBuildTemplate, p.GetExtensions())
+			}
+			if kafka.IsEnabled(workflow, plat) {
+				addExtensions(workflowBuildTemplate, kafka.GetExtensions())
 			}
 			buildInstance.Spec.BuildTemplate = *workflowBuildTemplate
 			if err = controllerutil.SetControllerReference(workflow, buildInstance, k.client.Scheme()); err != nil {
@@ -88,6 +99,9 @@ type SonataFlowBuildManager interface {
 	GetOrCreateBuild(workflow *operatorapi.SonataFlow) (*operatorapi.SonataFlowBuild, error)
 	// MarkToRestart tell the controller to restart this build in the next iteration
 	MarkToRestart(build *operatorapi.SonataFlowBuild) error
+	// EvictReusedImage removes the image reused by this build from the namespace build cache and restarts the build.
+	// Used when the reused image can't be pulled anymore, for example when it was removed from the registry.
+	EvictReusedImage(build *operatorapi.SonataFlowBuild) error
 }
 
 // NewSonataFlowBuildManager entry point to manage SonataFlowBuild instances.
@@ -99,17 +113,22 @@ func NewSonataFlowBuildManager(ctx context.Context, client client.Client) Sonata
 	}
 }
 
-// addPersistenceExtensions Adds the persistence related extensions to the current BuildTemplate if none of them is
+// addPersistenceExtensions Adds the persistence related extensions to the current BuildTemplate, see addExtensions.
+func addPersistenceExtensions(template *operatorapi.BuildTemplate, extensions []cfg.GroupArtifactId) {
+	addExtensions(template, extensions)
+}
+
+// addExtensions Adds the persistence or eventing related extensions to the current BuildTemplate if none of them is
 // already provided. If any of them is detected, its assumed that users might already have provided them in the
 // SonataFlowPlatform, so we just let the provided configuration.
-func addPersistenceExtensions(template *operatorapi.BuildTemplate) {
+func addExtensions(template *operatorapi.BuildTemplate, extensions []cfg.GroupArtifactId) {
 	quarkusExtensions := getBuildArg(template.BuildArgs, QuarkusExtensionsBuildArg)
 	if quarkusExtensions == nil {
 		template.BuildArgs = append(template.BuildArgs, v1.EnvVar{Name: QuarkusExtensionsBuildArg})
 		quarkusExtensions = &template.BuildArgs[len(template.BuildArgs)-1]
 	}
-	if !hasAnyExtensionPresent(quarkusExtensions, persistence.GetPostgreSQLExtensions()) {
-		for _, extension := range persistence.GetPostgreSQLExtensions() {
+	if !hasAnyExtensionPresent(quarkusExtensions, extensions) {
+		for _, extension := range extensions {
 			if len(quarkusExtensions.Value) > 0 {
 				quarkusExtensions.Value = quarkusExtensions.Value + ","
 			}
@@ -26,6 +26,7 @@ import (
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
 )
@@ -116,7 +117,7 @@ func prepareGetOrCreateBuildTest(t *testing.T, currentPlatform *operatorapi.Sona
 func Test_addPersistenceExtensionsWithEmptyArgs(t *testing.T) {
 	initializeControllersConfig(t)
 	buildTemplate := &operatorapi.BuildTemplate{}
-	addPersistenceExtensions(buildTemplate)
+	addPersistenceExtensions(buildTemplate, persistence.GetPostgreSQLExtensions())
 	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
 	assertContainsPersistence(t, buildTemplate.BuildArgs, 0)
 	test.RestoreControllersConfig(t)
@@ -129,7 +130,7 @@ func Test_addPersistenceExtensionsWithNoQuarkusExtensionsArg(t *testing.T) {
 			{Name: "VAR1"},
 		},
 	}
-	addPersistenceExtensions(buildTemplate)
+	addPersistenceExtensions(buildTemplate, persistence.GetPostgreSQLExtensions())
 	assert.Equal(t, 2, len(buildTemplate.BuildArgs))
 	assertContainsPersistence(t, buildTemplate.BuildArgs, 1)
 	test.RestoreControllersConfig(t)
@@ -143,7 +144,7 @@ func Test_addPersistenceExtensionsWithQuarkusExtensionsArgAndNoPersistenceExtens
 			{Name: "QUARKUS_EXTENSIONS", Value: "org.acme:org.acme.library:1.0.0"},
 		},
 	}
-	addPersistenceExtensions(buildTemplate)
+	addPersistenceExtensions(buildTemplate, persistence.GetPostgreSQLExtensions())
 	assert.Equal(t, 2, len(buildTemplate.BuildArgs))
 	assertContainsPersistence(t, buildTemplate.BuildArgs, 1)
 	test.RestoreControllersConfig(t)
@@ -157,13 +158,40 @@ func Test_addPersistenceExtensionsWithQuarkusExtensionsArgAndPersistenceExtensio
 			{Name: "QUARKUS_EXTENSIONS", Value: "org.acme:org.acme.library:1.0.0,io.quarkus:quarkus-jdbc-postgresql:8.8.0.Final"},
 		},
 	}
-	addPersistenceExtensions(buildTemplate)
+	addPersistenceExtensions(buildTemplate, persistence.GetPostgreSQLExtensions())
 	assert.Equal(t, 2, len(buildTemplate.BuildArgs))
 	assert.Equal(t, v1.EnvVar{Name: "VAR1", Value: "VALUE1"}, buildTemplate.BuildArgs[0])
 	assert.Equal(t, v1.EnvVar{Name: "QUARKUS_EXTENSIONS", Value: "org.acme:org.acme.library:1.0.0,io.quarkus:quarkus-jdbc-postgresql:8.8.0.Final"}, buildTemplate.BuildArgs[1])
 	test.RestoreControllersConfig(t)
 }
 
+func Test_addPersistenceExtensionsWithMySQLExtensions(t *testing.T) {
+	initializeControllersConfig(t)
+	buildTemplate := &operatorapi.BuildTemplate{
+		BuildArgs: []v1.EnvVar{
+			{Name: "QUARKUS_EXTENSIONS", Value: "org.acme:org.acme.library:1.0.0"},
+		},
+	}
+	addPersistenceExtensions(buildTemplate, persistence.GetMySQLExtensions())
+	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
+	for _, extension := range persistence.GetMySQLExtensions() {
+		assert.Contains(t, buildTemplate.BuildArgs[0].Value, extension.String())
+	}
+	assert.NotContains(t, buildTemplate.BuildArgs[0].Value, "quarkus-jdbc-postgresql")
+	test.RestoreControllersConfig(t)
+}
+
+func Test_addExtensionsWithKafkaEventingExtensions(t *testing.T) {
+	initializeControllersConfig(t)
+	buildTemplate := &operatorapi.BuildTemplate{}
+	addPersistenceExtensions(buildTemplate, persistence.GetPostgreSQLExtensions())
+	addExtensions(buildTemplate, kafka.GetExtensions())
+	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
+	assertContainsPersistence(t, buildTemplate.BuildArgs, 0)
+	assert.Contains(t, buildTemplate.BuildArgs[0].Value, "io.quarkus:quarkus-messaging-kafka")
+	test.RestoreControllersConfig(t)
+}
+
 func initializeControllersConfig(t *testing.T) {
 	// emulate the controllers config initialization
 	cfg, err := cfg.InitializeControllersCfgAt("../cfg/testdata/controllers-cfg-test.yaml")
@@ -250,6 +250,7 @@ func (o *openshiftBuilderManager) Reconcile(build *operatorapi.SonataFlowBuild)
 	if openshiftBuild.Status.Phase == buildv1.BuildPhaseError {
 		build.Status.Error = openshiftBuild.Status.Message
 	}
+	build.Status.Logs = openshiftBuild.Status.LogSnippet
 	build.Status.ImageTag = openshiftBuild.Status.OutputDockerImageReference
 
 	return build.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(openshiftBuild))
@@ -0,0 +1,249 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	signingPodSuffix        = "-signing"
+	signingContainerName    = "sign"
+	sbomContainerName       = "sbom"
+	signingKeyFile          = "cosign.key"
+	signingPasswordKey      = "cosign.password"
+	signingWorkspaceDir     = "/workspace"
+	sbomVolumeName          = "sbom"
+	signingKeyVolumeName    = "signing-key"
+	registryAuthVolumeName  = "registry-auth"
+	sbomFileName            = "sbom.json"
+	defaultSigningErrorText = "Pod failed"
+)
+
+var _ BuildManager = &signingBuildManager{}
+
+// signingBuildManager signs the images built by the platform build manager with cosign, and attests their SBOM
+// generated with syft. Both are pushed next to the image in the registry by a pod run once the image is built, the
+// build stays running until the pod finishes.
+type signingBuildManager struct {
+	buildManagerContext
+	manager BuildManager
+}
+
+func newSigningBuildManager(managerContext buildManagerContext, manager BuildManager) BuildManager {
+	return &signingBuildManager{buildManagerContext: managerContext, manager: manager}
+}
+
+func (s *signingBuildManager) Schedule(build *operatorapi.SonataFlowBuild) error {
+	// the pod signing the previous image is replaced once the new one is built
+	pod, err := s.fetchSigningPod(build)
+	if err != nil {
+		return err
+	}
+	if pod != nil {
+		if err = s.client.Delete(s.ctx, pod); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	build.Status.Signing = nil
+	return s.manager.Schedule(build)
+}
+
+func (s *signingBuildManager) Reconcile(build *operatorapi.SonataFlowBuild) error {
+	if err := s.manager.Reconcile(build); err != nil {
+		return err
+	}
+	if build.Status.BuildPhase != operatorapi.BuildPhaseSucceeded || build.Status.Signing != nil {
+		return nil
+	}
+
+	pod, err := s.fetchSigningPod(build)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		pod = s.newSigningPod(build)
+		if err = controllerutil.SetControllerReference(build, pod, s.client.Scheme()); err != nil {
+			return err
+		}
+		if err = s.client.Create(s.ctx, pod); err != nil {
+			return err
+		}
+		build.Status.BuildPhase = operatorapi.BuildPhaseRunning
+		return nil
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		signing := &operatorapi.ImageSigningStatus{}
+		if err = json.Unmarshal([]byte(getTerminationMessage(pod, signingContainerName)), signing); err != nil {
+			build.Status.BuildPhase = operatorapi.BuildPhaseFailed
+			build.Status.Error = fmt.Sprintf("Failed to read the signature of the image %s: %v", build.Status.ImageTag, err)
+			return nil
+		}
+		build.Status.Signing = signing
+	case corev1.PodFailed:
+		var messages []string
+		for _, container := range []string{sbomContainerName, signingContainerName} {
+			if containerMessage := getTerminationMessage(pod, container); len(containerMessage) > 0 {
+				messages = append(messages, containerMessage)
+			}
+		}
+		message := strings.Join(messages, "; ")
+		if len(message) == 0 {
+			message = defaultSigningErrorText
+		}
+		build.Status.BuildPhase = operatorapi.BuildPhaseFailed
+		build.Status.Error = fmt.Sprintf("Failed to sign the image %s: %s", build.Status.ImageTag, message)
+	default:
+		build.Status.BuildPhase = operatorapi.BuildPhaseRunning
+	}
+	return nil
+}
+
+func (s *signingBuildManager) fetchSigningPod(build *operatorapi.SonataFlowBuild) (*corev1.Pod, error) {
+	pod := &corev1.Pod{}
+	if err := s.client.Get(s.ctx, types.NamespacedName{Name: build.Name + signingPodSuffix, Namespace: build.Namespace}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pod, nil
+}
+
+// newSigningPod generates the SBOM of the built image in an init container, then the cosign container signs the image
+// digest, attests the SBOM, and reports the references in its termination message.
+func (s *signingBuildManager) newSigningPod(build *operatorapi.SonataFlowBuild) *corev1.Pod {
+	signing := s.platform.Spec.Build.Config.Signing
+	registry := s.platform.Spec.Build.Config.Registry
+	sbomFile := path.Join(signingWorkspaceDir, sbomVolumeName, sbomFileName)
+
+	sbomFormat, attestationType := "spdx-json", "spdxjson"
+	if signing.SBOMFormat == operatorapi.CycloneDXSBOMFormat {
+		sbomFormat, attestationType = "cyclonedx-json", "cyclonedx"
+	}
+
+	volumes := []corev1.Volume{
+		{Name: sbomVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: signingKeyVolumeName, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: signing.KeySecret}}},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: sbomVolumeName, MountPath: path.Join(signingWorkspaceDir, sbomVolumeName)},
+	}
+	var env []corev1.EnvVar
+	registrySecret := signing.RegistrySecret
+	if len(registrySecret) == 0 {
+		registrySecret = registry.Secret
+	}
+	if len(registrySecret) > 0 {
+		volumes = append(volumes, corev1.Volume{Name: registryAuthVolumeName, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName: registrySecret,
+			Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: "config.json"}},
+		}}})
+		mounts = append(mounts, corev1.VolumeMount{Name: registryAuthVolumeName, MountPath: path.Join(signingWorkspaceDir, registryAuthVolumeName), ReadOnly: true})
+		env = append(env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: path.Join(signingWorkspaceDir, registryAuthVolumeName)})
+	}
+
+	sbomEnv := append([]corev1.EnvVar{}, env...)
+	var cosignFlags []string
+	if registry.Insecure {
+		sbomEnv = append(sbomEnv,
+			corev1.EnvVar{Name: "SYFT_REGISTRY_INSECURE_SKIP_TLS_VERIFY", Value: "true"},
+			corev1.EnvVar{Name: "SYFT_REGISTRY_INSECURE_USE_HTTP", Value: "true"})
+		cosignFlags = append(cosignFlags, "--allow-insecure-registry")
+	}
+	flags := strings.Join(cosignFlags, " ")
+	keyFile := path.Join(signingWorkspaceDir, signingKeyVolumeName, signingKeyFile)
+	script := strings.Join([]string{
+		"set -e",
+		fmt.Sprintf(`DIGEST=$(cosign triangulate --type=digest %s "$IMAGE")`, flags),
+		fmt.Sprintf(`cosign sign --yes %s --key %s "$DIGEST"`, flags, keyFile),
+		fmt.Sprintf(`cosign attest --yes %s --key %s --type %s --predicate %s "$DIGEST"`, flags, keyFile, attestationType, sbomFile),
+		fmt.Sprintf(`SIGNATURE=$(cosign triangulate %s "$DIGEST")`, flags),
+		fmt.Sprintf(`ATTESTATION=$(cosign triangulate --type=attestation %s "$DIGEST")`, flags),
+		`printf '{"digest":"%s","signature":"%s","attestation":"%s"}' "${DIGEST#*@}" "$SIGNATURE" "$ATTESTATION" > /dev/termination-log`,
+	}, "\n")
+
+	signEnv := append(append([]corev1.EnvVar{}, env...),
+		corev1.EnvVar{Name: "IMAGE", Value: build.Status.ImageTag},
+		corev1.EnvVar{Name: "COSIGN_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: signing.KeySecret},
+			Key:                  signingPasswordKey,
+			Optional:             ptr.To(true),
+		}}})
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      build.Name + signingPodSuffix,
+			Namespace: build.Namespace,
+			Labels:    map[string]string{workflowproj.LabelWorkflow: build.Name},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Volumes:       volumes,
+			InitContainers: []corev1.Container{{
+				Name:                     sbomContainerName,
+				Image:                    cfg.GetCfg().SyftImageTag,
+				Args:                     []string{"scan", "registry:" + build.Status.ImageTag, "-o", sbomFormat + "=" + sbomFile},
+				Env:                      sbomEnv,
+				VolumeMounts:             mounts,
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			}},
+			Containers: []corev1.Container{{
+				Name:    signingContainerName,
+				Image:   cfg.GetCfg().CosignImageTag,
+				Command: []string{"sh", "-c", script},
+				Env:     signEnv,
+				VolumeMounts: append(mounts, corev1.VolumeMount{
+					Name: signingKeyVolumeName, MountPath: path.Join(signingWorkspaceDir, signingKeyVolumeName), ReadOnly: true,
+				}),
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			}},
+		},
+	}
+	return pod
+}
+
+func getTerminationMessage(pod *corev1.Pod, container string) string {
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.Name == container && status.State.Terminated != nil {
+			return strings.TrimSpace(status.State.Terminated.Message)
+		}
+	}
+	return ""
+}
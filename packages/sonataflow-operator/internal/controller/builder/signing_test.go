@@ -0,0 +1,110 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func Test_signingBuildManager(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	platform.Spec.Build.Config.Registry.Secret = "regcred"
+	platform.Spec.Build.Config.Registry.Insecure = true
+	platform.Spec.Build.Config.Signing = &operatorapi.ImageSigningSpec{KeySecret: "cosign-keys", SBOMFormat: operatorapi.CycloneDXSBOMFormat}
+	config := test.GetSonataFlowBuilderConfig(ns)
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, config).Build()
+
+	inner := &fakeBuildManager{}
+	buildManager := newSigningBuildManager(buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         platform,
+		builderConfigMap: config,
+	}, inner)
+
+	build, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, 1, inner.scheduled)
+
+	// the image is built, the build keeps running while the image is signed
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseRunning, build.Status.BuildPhase)
+	pod := &v1.Pod{}
+	podName := types.NamespacedName{Namespace: ns, Name: build.Name + signingPodSuffix}
+	assert.NoError(t, client.Get(context.TODO(), podName, pod))
+	assert.Contains(t, strings.Join(pod.Spec.InitContainers[0].Args, " "), "registry:"+build.Status.ImageTag+" -o cyclonedx-json=/workspace/sbom/sbom.json")
+	script := pod.Spec.Containers[0].Command[2]
+	assert.Contains(t, script, "cosign attest --yes --allow-insecure-registry --key /workspace/signing-key/cosign.key --type cyclonedx")
+	assert.Contains(t, pod.Spec.Containers[0].Env, v1.EnvVar{Name: "DOCKER_CONFIG", Value: "/workspace/registry-auth"})
+	assert.Contains(t, pod.Spec.Containers[0].Env, v1.EnvVar{Name: "IMAGE", Value: build.Status.ImageTag})
+
+	pod.Status.Phase = v1.PodRunning
+	assert.NoError(t, client.Status().Update(context.TODO(), pod))
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseRunning, build.Status.BuildPhase)
+
+	pod.Status.Phase = v1.PodSucceeded
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name: signingContainerName,
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+			Message: `{"digest":"sha256:abc","signature":"registry.local/greeting:sha256-abc.sig","attestation":"registry.local/greeting:sha256-abc.att"}`,
+		}},
+	}}
+	assert.NoError(t, client.Status().Update(context.TODO(), pod))
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseSucceeded, build.Status.BuildPhase)
+	assert.Equal(t, &operatorapi.ImageSigningStatus{
+		Digest:      "sha256:abc",
+		Signature:   "registry.local/greeting:sha256-abc.sig",
+		Attestation: "registry.local/greeting:sha256-abc.att",
+	}, build.Status.Signing)
+
+	// a new build replaces the signature
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Nil(t, build.Status.Signing)
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.NoError(t, client.Get(context.TODO(), podName, pod))
+
+	pod.Status.Phase = v1.PodFailed
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name:  signingContainerName,
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Message: "error: no matching key"}},
+	}}
+	pod.Status.InitContainerStatuses = []v1.ContainerStatus{{
+		Name:  sbomContainerName,
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Message: "scan completed with warnings"}},
+	}}
+	assert.NoError(t, client.Status().Update(context.TODO(), pod))
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseFailed, build.Status.BuildPhase)
+	assert.Contains(t, build.Status.Error, "scan completed with warnings; error: no matching key")
+}
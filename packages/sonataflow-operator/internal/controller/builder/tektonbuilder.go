@@ -0,0 +1,379 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	builder "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/builder/kubernetes"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/workflowdef"
+	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	tektonSourceWorkspace       = "source"
+	tektonRegistryAuthWorkspace = "registry-auth"
+	tektonResourcesWorkspace    = "resources-%d"
+	tektonWorkspacesDir         = "/workspace"
+	// tektonContextDir is the writable build context assembled from the workspaces
+	tektonContextDir = "/workspace/context"
+	// tektonSucceededCondition is the Tekton condition summarizing the PipelineRun
+	tektonSucceededCondition = "Succeeded"
+	tektonContextSuffix      = "-tekton-context"
+	// tektonPipelineRunLabel is set by Tekton to the pods of the PipelineRun
+	tektonPipelineRunLabel = "tekton.dev/pipelineRun"
+)
+
+// PipelineRunGroupVersionKind is the Tekton PipelineRun handled as unstructured content, so the operator doesn't
+// depend on the Tekton Pipelines API.
+var PipelineRunGroupVersionKind = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "PipelineRun"}
+
+// tektonCancelledReasons are the reasons of the PipelineRuns cancelled by the users.
+var tektonCancelledReasons = []string{"Cancelled", "PipelineRunCancelled", "CancelledRunFinally", "StoppedRunFinally"}
+
+var _ BuildManager = &tektonBuilderManager{}
+
+// tektonBuilderManager builds the workflow images with a Tekton PipelineRun. The run builds the Containerfile of the
+// builder ConfigMap with Buildah, in a context assembled from the workflow ConfigMaps, and pushes the image to the
+// platform registry.
+//
+// Every Schedule starts a new PipelineRun, the PipelineRuns are immutable, so the build restarts with a fresh run.
+type tektonBuilderManager struct {
+	buildManagerContext
+	// podsClient fetches the logs of the failed steps
+	podsClient kubernetes.Interface
+}
+
+func newTektonBuilderManager(managerContext buildManagerContext, cliConfig *rest.Config) (BuildManager, error) {
+	podsClient, err := kubernetes.NewForConfig(cliConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newTektonBuilderManagerWithClient(managerContext, podsClient), nil
+}
+
+// newTektonBuilderManagerWithClient exposes the pods client, so it can be injected by the tests.
+func newTektonBuilderManagerWithClient(managerContext buildManagerContext, podsClient kubernetes.Interface) BuildManager {
+	return &tektonBuilderManager{buildManagerContext: managerContext, podsClient: podsClient}
+}
+
+func (t *tektonBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) error {
+	workflow, err := t.fetchWorkflowForBuild(build)
+	if err != nil {
+		return err
+	}
+	if err = t.ensureContextConfigMap(build, workflow); err != nil {
+		return err
+	}
+	pipelineRun, err := t.createPipelineRun(build, workflow)
+	if err != nil {
+		return err
+	}
+	build.Status.BuildPhase = operatorapi.BuildPhaseScheduling
+	build.Status.Error = ""
+	build.Status.Logs = ""
+	build.Status.ImageTag = t.getImageTag(workflow, build)
+	return build.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(pipelineRun))
+}
+
+func (t *tektonBuilderManager) Reconcile(build *operatorapi.SonataFlowBuild) error {
+	pipelineRun, err := t.fetchPipelineRun(build)
+	if err != nil {
+		return err
+	}
+	if pipelineRun == nil {
+		// the run is gone, we push another one
+		return t.Schedule(build)
+	}
+	phase, message := getTektonBuildPhase(pipelineRun)
+	if phase == operatorapi.BuildPhaseFailed && build.Status.BuildPhase != operatorapi.BuildPhaseFailed {
+		if build.Status.Logs, err = t.getFailedStepsLogs(pipelineRun); err != nil {
+			return err
+		}
+	}
+	build.Status.BuildPhase, build.Status.Error = phase, message
+	return build.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(pipelineRun))
+}
+
+// getFailedStepsLogs fetches the logs tail of the failed steps, every TaskRun of the PipelineRun runs in its own pod.
+func (t *tektonBuilderManager) getFailedStepsLogs(pipelineRun *unstructured.Unstructured) (string, error) {
+	pods := &corev1.PodList{}
+	if err := t.client.List(t.ctx, pods, client.InNamespace(pipelineRun.GetNamespace()), client.MatchingLabels{tektonPipelineRunLabel: pipelineRun.GetName()}); err != nil {
+		return "", err
+	}
+	var logs []string
+	for i := range pods.Items {
+		if podLogs := builder.GetFailedContainersLogs(t.ctx, t.podsClient, &pods.Items[i]); len(podLogs) > 0 {
+			logs = append(logs, podLogs)
+		}
+	}
+	return builder.TrimLogs(strings.Join(logs, "\n")), nil
+}
+
+// getTektonBuildPhase maps the Succeeded condition of the PipelineRun to the build phase.
+func getTektonBuildPhase(pipelineRun *unstructured.Unstructured) (operatorapi.BuildPhase, string) {
+	conditions, _, _ := unstructured.NestedSlice(pipelineRun.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != tektonSucceededCondition {
+			continue
+		}
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		switch condition["status"] {
+		case string(corev1.ConditionTrue):
+			return operatorapi.BuildPhaseSucceeded, ""
+		case string(corev1.ConditionFalse):
+			for _, cancelled := range tektonCancelledReasons {
+				if reason == cancelled {
+					return operatorapi.BuildPhaseInterrupted, message
+				}
+			}
+			return operatorapi.BuildPhaseFailed, message
+		default:
+			if reason == "Pending" || reason == "PipelineRunPending" {
+				return operatorapi.BuildPhasePending, ""
+			}
+			return operatorapi.BuildPhaseRunning, ""
+		}
+	}
+	return operatorapi.BuildPhaseScheduling, ""
+}
+
+func (t *tektonBuilderManager) fetchPipelineRun(build *operatorapi.SonataFlowBuild) (*unstructured.Unstructured, error) {
+	ref := &corev1.TypedLocalObjectReference{}
+	if err := build.Status.GetInnerBuild(ref); err != nil {
+		return nil, err
+	}
+	if len(ref.Name) == 0 {
+		return nil, nil
+	}
+	pipelineRun := &unstructured.Unstructured{}
+	pipelineRun.SetGroupVersionKind(PipelineRunGroupVersionKind)
+	if err := t.client.Get(t.ctx, types.NamespacedName{Name: ref.Name, Namespace: build.Namespace}, pipelineRun); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pipelineRun, nil
+}
+
+// ensureContextConfigMap holds the generated Containerfile and workflow definition, they're mounted as the source workspace.
+func (t *tektonBuilderManager) ensureContextConfigMap(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) error {
+	workflowDef, err := workflowdef.GetJSONWorkflow(workflow, t.ctx)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{
+		resourceDockerfile: platform.GetCustomizedBuilderDockerfile(t.builderConfigMap.Data[defaultBuilderResourceName], *t.platform),
+		workflow.Name + t.builderConfigMap.Data[configKeyDefaultExtension]: string(workflowDef),
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: build.Name + tektonContextSuffix, Namespace: build.Namespace},
+	}
+	_, err = controllerutil.CreateOrPatch(t.ctx, t.client, configMap, func() error {
+		workflowproj.SetMergedLabels(workflow, configMap)
+		configMap.Data = data
+		return controllerutil.SetControllerReference(build, configMap, t.client.Scheme())
+	})
+	return err
+}
+
+func (t *tektonBuilderManager) createPipelineRun(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) (*unstructured.Unstructured, error) {
+	pipelineRun, err := t.newPipelineRun(build, workflow)
+	if err != nil {
+		return nil, err
+	}
+	if err = controllerutil.SetControllerReference(build, pipelineRun, t.client.Scheme()); err != nil {
+		return nil, err
+	}
+	if err = t.client.Create(t.ctx, pipelineRun); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("the %s build strategy requires Tekton Pipelines to be installed in the cluster: %w", operatorapi.TektonBuildStrategy, err)
+		}
+		return nil, err
+	}
+	return pipelineRun, nil
+}
+
+func (t *tektonBuilderManager) getImageTag(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) string {
+	if address := t.platform.Spec.Build.Config.Registry.Address; len(address) > 0 {
+		return address + "/" + buildNamespacedImageTagForBuild(workflow, build)
+	}
+	return buildNamespacedImageTagForBuild(workflow, build)
+}
+
+func (t *tektonBuilderManager) newPipelineRun(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) (*unstructured.Unstructured, error) {
+	imageTag := t.getImageTag(workflow, build)
+	registry := t.platform.Spec.Build.Config.Registry
+
+	// every workspace is bound to a ConfigMap copied into the build context
+	workspaces := []interface{}{
+		map[string]interface{}{"name": tektonSourceWorkspace},
+	}
+	bindings := []interface{}{
+		map[string]interface{}{"name": tektonSourceWorkspace, "configMap": map[string]interface{}{"name": build.Name + tektonContextSuffix}},
+	}
+	script := []string{
+		"set -e",
+		"mkdir -p " + tektonContextDir,
+		fmt.Sprintf("cp -L %s/* %s/", path.Join(tektonWorkspacesDir, tektonSourceWorkspace), tektonContextDir),
+	}
+	// copied, so the workflow spec is never written through the shared backing array
+	resources := append([]operatorapi.ConfigMapWorkflowResource{}, workflow.Spec.Resources.ConfigMaps...)
+	resources = append(resources, buildWorkflowPropertyResources(workflow)...)
+	for i, res := range resources {
+		name := fmt.Sprintf(tektonResourcesWorkspace, i)
+		destination := path.Join(tektonContextDir, res.WorkflowPath)
+		workspaces = append(workspaces, map[string]interface{}{"name": name})
+		bindings = append(bindings, map[string]interface{}{"name": name, "configMap": map[string]interface{}{"name": res.ConfigMap.Name}})
+		script = append(script,
+			fmt.Sprintf("mkdir -p %s", shellQuote(destination)),
+			fmt.Sprintf("cp -L %s/* %s/", path.Join(tektonWorkspacesDir, name), shellQuote(destination)))
+	}
+	if len(registry.Secret) > 0 {
+		workspaces = append(workspaces, map[string]interface{}{"name": tektonRegistryAuthWorkspace})
+		bindings = append(bindings, map[string]interface{}{"name": tektonRegistryAuthWorkspace, "secret": map[string]interface{}{"secretName": registry.Secret}})
+		authDir := path.Join(tektonWorkspacesDir, tektonRegistryAuthWorkspace)
+		script = append(script,
+			fmt.Sprintf("if [ -f %[1]s/%[2]s ]; then export REGISTRY_AUTH_FILE=%[1]s/%[2]s; else export REGISTRY_AUTH_FILE=%[1]s/config.json; fi", authDir, corev1.DockerConfigJsonKey))
+	}
+
+	budArgs := []string{"--storage-driver=vfs", "--isolation=chroot", "--file=Dockerfile", "--tag=" + imageTag}
+	pushArgs := []string{"--storage-driver=vfs"}
+	if registry.Insecure {
+		budArgs = append(budArgs, "--tls-verify=false")
+		pushArgs = append(pushArgs, "--tls-verify=false")
+	}
+	// the build args are given as the step env, so they can refer to ConfigMaps and Secrets
+	env := append([]corev1.EnvVar{}, build.Spec.Envs...)
+	for _, buildArg := range build.Spec.BuildArgs {
+		budArgs = append(budArgs, "--build-arg="+buildArg.Name)
+		env = append(env, buildArg)
+	}
+	budArgs = append(budArgs, build.Spec.Arguments...)
+	// the image tag and the bud arguments are given to the script as the step args, so they're never parsed by the shell
+	args := append([]string{imageTag}, budArgs...)
+	args = append(args, tektonContextDir)
+	script = append(script,
+		`tag="$1"`,
+		"shift",
+		`buildah bud "$@"`,
+		fmt.Sprintf(`buildah push %s "$tag"`, strings.Join(pushArgs, " ")))
+
+	var err error
+	step := map[string]interface{}{
+		"name":       "build-and-push",
+		"image":      cfg.GetCfg().BuildahImageTag,
+		"workingDir": tektonContextDir,
+		"script":     strings.Join(script, "\n") + "\n",
+		"args":       toInterfaceSlice(args),
+	}
+	if len(env) > 0 {
+		if step["env"], err = toUnstructuredSlice(env); err != nil {
+			return nil, err
+		}
+	}
+	if len(build.Spec.Resources.Limits) > 0 || len(build.Spec.Resources.Requests) > 0 {
+		if step["computeResources"], err = runtime.DefaultUnstructuredConverter.ToUnstructured(&build.Spec.Resources); err != nil {
+			return nil, err
+		}
+	}
+	taskWorkspaces := make([]interface{}, 0, len(workspaces))
+	for _, w := range workspaces {
+		name := w.(map[string]interface{})["name"]
+		taskWorkspaces = append(taskWorkspaces, map[string]interface{}{"name": name, "workspace": name})
+	}
+
+	pipelineRun := &unstructured.Unstructured{}
+	pipelineRun.SetGroupVersionKind(PipelineRunGroupVersionKind)
+	pipelineRun.SetNamespace(build.Namespace)
+	pipelineRun.SetGenerateName(build.Name + "-")
+	// Tekton copies the labels to the pods, so the workflow selector labels are left out
+	pipelineRun.SetLabels(map[string]string{
+		workflowproj.LabelWorkflow:          workflow.Name,
+		workflowproj.LabelWorkflowNamespace: workflow.Namespace,
+	})
+	pipelineRun.Object["spec"] = map[string]interface{}{
+		"pipelineSpec": map[string]interface{}{
+			"workspaces": workspaces,
+			"tasks": []interface{}{
+				map[string]interface{}{
+					"name":       "build",
+					"workspaces": taskWorkspaces,
+					"taskSpec": map[string]interface{}{
+						"workspaces": workspaces,
+						"steps":      []interface{}{step},
+					},
+				},
+			},
+		},
+		"workspaces": bindings,
+	}
+	if timeout := t.platform.Spec.Build.Config.GetTimeout(); timeout.Duration > 0 {
+		_ = unstructured.SetNestedField(pipelineRun.Object, timeout.Duration.String(), "spec", "timeouts", "pipeline")
+	}
+	return pipelineRun, nil
+}
+
+func toUnstructuredSlice(envs []corev1.EnvVar) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(envs))
+	for i := range envs {
+		env, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&envs[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, env)
+	}
+	return result, nil
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
+
+// shellQuote quotes the value as a single word of the step script.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,157 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/workflowdef"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func Test_tektonBuilderManager_Schedule(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	platform.Spec.Build.Config.BuildStrategy = operatorapi.TektonBuildStrategy
+	platform.Spec.Build.Config.Registry.Address = "registry.local:5000"
+	platform.Spec.Build.Config.Registry.Secret = "regcred"
+	config := test.GetSonataFlowBuilderConfig(ns)
+	externalCm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "myopenapis", Namespace: ns}}
+	workflow.Spec.Resources.ConfigMaps = append(workflow.Spec.Resources.ConfigMaps,
+		operatorapi.ConfigMapWorkflowResource{ConfigMap: v1.LocalObjectReference{Name: externalCm.Name}, WorkflowPath: "specs"})
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, config, externalCm).Build()
+
+	buildManager := newTektonBuilderManagerWithClient(buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         platform,
+		builderConfigMap: config,
+	}, fake.NewSimpleClientset())
+
+	build, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	build.Spec.BuildArgs = []v1.EnvVar{{Name: "QUARKUS_EXTENSIONS", Value: "io.quarkus:quarkus-jdbc-postgresql"}}
+	build.Spec.Arguments = []string{"--label=owner=$(id -u)"}
+	assert.NoError(t, buildManager.Schedule(build))
+	assert.Equal(t, operatorapi.BuildPhaseScheduling, build.Status.BuildPhase)
+	assert.Equal(t, "registry.local:5000/"+buildNamespacedImageTag(workflow), build.Status.ImageTag)
+
+	contextCm := &v1.ConfigMap{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: build.Name + tektonContextSuffix}, contextCm))
+	assert.Contains(t, contextCm.Data[resourceDockerfile], "FROM "+workflowdef.GetDefaultWorkflowBuilderImageTag()+" AS builder")
+	assert.Contains(t, contextCm.Data, workflow.Name+config.Data[configKeyDefaultExtension])
+
+	ref := &v1.TypedLocalObjectReference{}
+	assert.NoError(t, build.Status.GetInnerBuild(ref))
+	pipelineRun := &unstructured.Unstructured{}
+	pipelineRun.SetGroupVersionKind(PipelineRunGroupVersionKind)
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: ref.Name}, pipelineRun))
+	bindings, _, _ := unstructured.NestedSlice(pipelineRun.Object, "spec", "workspaces")
+	// source, external resources, properties and registry auth
+	assert.Len(t, bindings, 5)
+	tasks, _, _ := unstructured.NestedSlice(pipelineRun.Object, "spec", "pipelineSpec", "tasks")
+	assert.Len(t, tasks, 1)
+	steps, _, _ := unstructured.NestedSlice(tasks[0].(map[string]interface{}), "taskSpec", "steps")
+	script := steps[0].(map[string]interface{})["script"].(string)
+	assert.Contains(t, script, `buildah push --storage-driver=vfs "$tag"`)
+	assert.Contains(t, script, "REGISTRY_AUTH_FILE")
+	assert.Contains(t, script, "'/workspace/context/specs'")
+	assert.NotContains(t, script, build.Spec.Arguments[0])
+	args, _, _ := unstructured.NestedStringSlice(steps[0].(map[string]interface{}), "args")
+	assert.Equal(t, "registry.local:5000/"+buildNamespacedImageTag(workflow), args[0])
+	assert.Contains(t, args, "--build-arg=QUARKUS_EXTENSIONS")
+	assert.Contains(t, args, build.Spec.Arguments[0])
+	assert.Equal(t, tektonContextDir, args[len(args)-1])
+}
+
+func Test_tektonBuilderManager_Reconcile(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	platform := test.GetBasePlatformInReadyPhase(ns)
+	platform.Spec.Build.Config.BuildStrategy = operatorapi.TektonBuildStrategy
+	config := test.GetSonataFlowBuilderConfig(ns)
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, platform, config).Build()
+
+	buildManager := newTektonBuilderManagerWithClient(buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         platform,
+		builderConfigMap: config,
+	}, fake.NewSimpleClientset())
+
+	build, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(build))
+
+	ref := &v1.TypedLocalObjectReference{}
+	assert.NoError(t, build.Status.GetInnerBuild(ref))
+	pipelineRun := &unstructured.Unstructured{}
+	pipelineRun.SetGroupVersionKind(PipelineRunGroupVersionKind)
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: ref.Name}, pipelineRun))
+
+	setCondition := func(status, reason, message string) {
+		assert.NoError(t, unstructured.SetNestedSlice(pipelineRun.Object, []interface{}{
+			map[string]interface{}{"type": tektonSucceededCondition, "status": status, "reason": reason, "message": message},
+		}, "status", "conditions"))
+		assert.NoError(t, client.Update(context.TODO(), pipelineRun))
+	}
+
+	setCondition("Unknown", "Running", "")
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseRunning, build.Status.BuildPhase)
+
+	taskRunPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: ref.Name + "-build-pod", Namespace: ns, Labels: map[string]string{tektonPipelineRunLabel: ref.Name}},
+		Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+			{Name: "step-build-and-push", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}}},
+		}},
+	}
+	assert.NoError(t, client.Create(context.TODO(), taskRunPod))
+	setCondition("False", "Failed", "step build-and-push failed")
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseFailed, build.Status.BuildPhase)
+	assert.Equal(t, "step build-and-push failed", build.Status.Error)
+	// the fake clientset always answers with the same logs
+	assert.Equal(t, "fake logs", build.Status.Logs)
+
+	setCondition("False", "Cancelled", "cancelled by the user")
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseInterrupted, build.Status.BuildPhase)
+
+	setCondition("True", "Succeeded", "")
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseSucceeded, build.Status.BuildPhase)
+	assert.Empty(t, build.Status.Error)
+
+	// a removed run is scheduled again
+	assert.NoError(t, client.Delete(context.TODO(), pipelineRun))
+	assert.NoError(t, buildManager.Reconcile(build))
+	assert.Equal(t, operatorapi.BuildPhaseScheduling, build.Status.BuildPhase)
+}
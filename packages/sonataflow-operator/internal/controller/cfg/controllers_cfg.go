@@ -43,11 +43,19 @@ var defaultControllersCfg = &ControllersCfg{
 	DefaultPvcKanikoSize:          "1Gi",
 	KanikoDefaultWarmerImageTag:   "gcr.io/kaniko-project/warmer:v1.9.0",
 	KanikoExecutorImageTag:        "gcr.io/kaniko-project/executor:v1.9.0",
+	BuildahImageTag:               "quay.io/buildah/stable:v1.37",
+	BuildKitImageTag:              "docker.io/moby/buildkit:v0.16.0-rootless",
+	CosignImageTag:                "ghcr.io/sigstore/cosign/cosign:v2.4.1-dev",
+	SyftImageTag:                  "docker.io/anchore/syft:v1.14.0",
 	JobsServicePostgreSQLImageTag: getEnvOrDefault("RELATED_IMAGE_JOBS_SERVICE_POSTGRESQL", ""),
 	JobsServiceEphemeralImageTag:  getEnvOrDefault("RELATED_IMAGE_JOBS_SERVICE_EPHEMERAL", ""),
+	JobsServiceMySQLImageTag:      getEnvOrDefault("RELATED_IMAGE_JOBS_SERVICE_MYSQL", ""),
 	DataIndexPostgreSQLImageTag:   getEnvOrDefault("RELATED_IMAGE_DATA_INDEX_POSTGRESQL", ""),
 	DataIndexEphemeralImageTag:    getEnvOrDefault("RELATED_IMAGE_DATA_INDEX_EPHEMERAL", ""),
+	DataIndexMySQLImageTag:        getEnvOrDefault("RELATED_IMAGE_DATA_INDEX_MYSQL", ""),
 	DbMigratorToolImageTag:        getEnvOrDefault("RELATED_IMAGE_DB_MIGRATOR_TOOL", ""),
+	ManagedPostgreSQLImageTag:     "docker.io/library/postgres:15-alpine",
+	ScheduleRunnerImageTag:        "registry.access.redhat.com/ubi9/ubi-minimal:9.4",
 	SonataFlowBaseBuilderImageTag: getEnvOrDefault("RELATED_IMAGE_BASE_BUILDER", ""),
 	SonataFlowDevModeImageTag:     getEnvOrDefault("RELATED_IMAGE_DEVMODE", ""),
 	BuilderConfigMapName:          "sonataflow-operator-builder-config",
@@ -70,15 +78,25 @@ type ControllersCfg struct {
 	HealthFailureThresholdDevMode   int32             `yaml:"healthFailureThresholdDevMode,omitempty"`
 	KanikoDefaultWarmerImageTag     string            `yaml:"kanikoDefaultWarmerImageTag,omitempty"`
 	KanikoExecutorImageTag          string            `yaml:"kanikoExecutorImageTag,omitempty"`
+	BuildahImageTag                 string            `yaml:"buildahImageTag,omitempty"`
+	BuildKitImageTag                string            `yaml:"buildKitImageTag,omitempty"`
+	CosignImageTag                  string            `yaml:"cosignImageTag,omitempty"`
+	SyftImageTag                    string            `yaml:"syftImageTag,omitempty"`
 	JobsServicePostgreSQLImageTag   string            `yaml:"jobsServicePostgreSQLImageTag,omitempty"`
 	JobsServiceEphemeralImageTag    string            `yaml:"jobsServiceEphemeralImageTag,omitempty"`
+	JobsServiceMySQLImageTag        string            `yaml:"jobsServiceMySQLImageTag,omitempty"`
 	DataIndexPostgreSQLImageTag     string            `yaml:"dataIndexPostgreSQLImageTag,omitempty"`
 	DataIndexEphemeralImageTag      string            `yaml:"dataIndexEphemeralImageTag,omitempty"`
+	DataIndexMySQLImageTag          string            `yaml:"dataIndexMySQLImageTag,omitempty"`
 	DbMigratorToolImageTag          string            `yaml:"dbMigratorToolImageTag,omitempty"`
+	ManagedPostgreSQLImageTag       string            `yaml:"managedPostgreSQLImageTag,omitempty"`
+	ScheduleRunnerImageTag          string            `yaml:"scheduleRunnerImageTag,omitempty"`
 	SonataFlowBaseBuilderImageTag   string            `yaml:"sonataFlowBaseBuilderImageTag,omitempty"`
 	SonataFlowDevModeImageTag       string            `yaml:"sonataFlowDevModeImageTag,omitempty"`
 	BuilderConfigMapName            string            `yaml:"builderConfigMapName,omitempty"`
 	PostgreSQLPersistenceExtensions []GroupArtifactId `yaml:"postgreSQLPersistenceExtensions,omitempty"`
+	MySQLPersistenceExtensions      []GroupArtifactId `yaml:"mySQLPersistenceExtensions,omitempty"`
+	KafkaEventingExtensions         []GroupArtifactId `yaml:"kafkaEventingExtensions,omitempty"`
 	KogitoEventsGrouping            bool              `yaml:"kogitoEventsGrouping,omitempty"`
 	KogitoEventsGroupingBinary      bool              `yaml:"KogitoEventsGroupingBinary,omitempty"`
 	KogitoEventsGroupingCompress    bool              `yaml:"KogitoEventsGroupingCompress,omitempty"`
@@ -131,8 +149,10 @@ func GetCfg() *ControllersCfg {
 func useEnvVarIfConfigEmpty(cfg *ControllersCfg) {
 	cfg.JobsServicePostgreSQLImageTag = fallback(cfg.JobsServicePostgreSQLImageTag, os.Getenv("RELATED_IMAGE_JOBS_SERVICE_POSTGRESQL"))
 	cfg.JobsServiceEphemeralImageTag = fallback(cfg.JobsServiceEphemeralImageTag, os.Getenv("RELATED_IMAGE_JOBS_SERVICE_EPHEMERAL"))
+	cfg.JobsServiceMySQLImageTag = fallback(cfg.JobsServiceMySQLImageTag, os.Getenv("RELATED_IMAGE_JOBS_SERVICE_MYSQL"))
 	cfg.DataIndexPostgreSQLImageTag = fallback(cfg.DataIndexPostgreSQLImageTag, os.Getenv("RELATED_IMAGE_DATA_INDEX_POSTGRESQL"))
 	cfg.DataIndexEphemeralImageTag = fallback(cfg.DataIndexEphemeralImageTag, os.Getenv("RELATED_IMAGE_DATA_INDEX_EPHEMERAL"))
+	cfg.DataIndexMySQLImageTag = fallback(cfg.DataIndexMySQLImageTag, os.Getenv("RELATED_IMAGE_DATA_INDEX_MYSQL"))
 	cfg.DbMigratorToolImageTag = fallback(cfg.DbMigratorToolImageTag, os.Getenv("RELATED_IMAGE_DB_MIGRATOR_TOOL"))
 	cfg.SonataFlowBaseBuilderImageTag = fallback(cfg.SonataFlowBaseBuilderImageTag, os.Getenv("RELATED_IMAGE_BASE_BUILDER"))
 	cfg.SonataFlowDevModeImageTag = fallback(cfg.SonataFlowDevModeImageTag, os.Getenv("RELATED_IMAGE_DEVMODE"))
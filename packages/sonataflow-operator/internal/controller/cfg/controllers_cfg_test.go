@@ -51,6 +51,8 @@ func TestInitializeControllersCfgAt_ValidFile(t *testing.T) {
 		GroupId:    "org.kie",
 		ArtifactId: "kie-addons-quarkus-persistence-jdbc",
 	}, postgresExtensions[2])
+	assert.Equal(t, []GroupArtifactId{{GroupId: "io.quarkus", ArtifactId: "quarkus-jdbc-mysql"}}, cfg.MySQLPersistenceExtensions)
+	assert.Equal(t, []GroupArtifactId{{GroupId: "io.quarkus", ArtifactId: "quarkus-messaging-kafka"}}, cfg.KafkaEventingExtensions)
 	assert.True(t, cfg.KogitoEventsGrouping)
 	assert.True(t, cfg.KogitoEventsGroupingBinary)
 	assert.False(t, cfg.KogitoEventsGroupingCompress)
@@ -0,0 +1,93 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	configMapKind = "configmaps"
+	secretKind    = "secrets"
+)
+
+// configServiceCatalog resolves addresses kept as entries of ConfigMaps or Secrets, for example the urls of services
+// living outside the cluster. The entry is selected with the key query param.
+type configServiceCatalog struct {
+	Client client.Client
+}
+
+func newConfigServiceCatalog(cli client.Client) configServiceCatalog {
+	return configServiceCatalog{
+		Client: cli,
+	}
+}
+
+func (c configServiceCatalog) Query(ctx context.Context, uri ResourceUri, outputFormat string) (string, error) {
+	switch uri.GVK.Kind {
+	case configMapKind:
+		return c.resolveConfigMapQuery(ctx, uri)
+	case secretKind:
+		return c.resolveSecretQuery(ctx, uri)
+	default:
+		return "", fmt.Errorf("resolution of config kind: %s is not implemented", uri.GVK.Kind)
+	}
+}
+
+func (c configServiceCatalog) resolveConfigMapQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	configMap, err := findConfigMap(ctx, c.Client, uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	key := uri.GetKey()
+	if value, ok := configMap.Data[key]; ok {
+		return resolveConfigValue(uri, value)
+	}
+	if value, ok := configMap.BinaryData[key]; ok {
+		return resolveConfigValue(uri, string(value))
+	}
+	return "", fmt.Errorf("no key: %s was found in the configmap: %s in namespace: %s", key, uri.Name, uri.Namespace)
+}
+
+func (c configServiceCatalog) resolveSecretQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	secret, err := findSecret(ctx, c.Client, uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	key := uri.GetKey()
+	if value, ok := secret.Data[key]; ok {
+		return resolveConfigValue(uri, string(value))
+	}
+	if value, ok := secret.StringData[key]; ok {
+		return resolveConfigValue(uri, value)
+	}
+	return "", fmt.Errorf("no key: %s was found in the secret: %s in namespace: %s", key, uri.Name, uri.Namespace)
+}
+
+func resolveConfigValue(uri ResourceUri, value string) (string, error) {
+	if value = strings.TrimSpace(value); len(value) == 0 {
+		return "", fmt.Errorf("the key: %s has no value in the %s: %s in namespace: %s", uri.GetKey(), strings.TrimSuffix(uri.GVK.Kind, "s"), uri.Name, uri.Namespace)
+	}
+	return value, nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	gateway1Name        = "gateway1Name"
+	httpRoute1Name      = "httpRoute1Name"
+	virtualService1Name = "virtualService1Name"
+	serviceEntry1Name   = "serviceEntry1Name"
+)
+
+func Test_QueryGatewayHTTPRouteWithHostname(t *testing.T) {
+	route := mockHTTPRoute(namespace1, httpRoute1Name, gateway1Name, "", "*.example.com", "route.example.com")
+	gateway := mockGateway(namespace1, gateway1Name, "10.1.5.20",
+		mockGatewayListener("http", "HTTP", 8080), mockGatewayListener("https", "HTTPS", 8443))
+	doTestQueryGatewayHTTPRoute(t, "https://route.example.com:8443", route, gateway)
+}
+
+func Test_QueryGatewayHTTPRouteWithSectionName(t *testing.T) {
+	route := mockHTTPRoute(namespace1, httpRoute1Name, gateway1Name, "http", "route.example.com")
+	gateway := mockGateway(namespace1, gateway1Name, "10.1.5.20",
+		mockGatewayListener("http", "HTTP", 80), mockGatewayListener("https", "HTTPS", 443))
+	doTestQueryGatewayHTTPRoute(t, "http://route.example.com:80", route, gateway)
+}
+
+func Test_QueryGatewayHTTPRouteWithGatewayAddress(t *testing.T) {
+	route := mockHTTPRoute(namespace1, httpRoute1Name, gateway1Name, "")
+	gateway := mockGateway(namespace1, gateway1Name, "10.1.5.20", mockGatewayListener("http", "HTTP", 8080))
+	doTestQueryGatewayHTTPRoute(t, "http://10.1.5.20:8080", route, gateway)
+}
+
+func Test_QueryGatewayHTTPRouteWithoutHostnameNorGateway(t *testing.T) {
+	route := mockHTTPRoute(namespace1, httpRoute1Name, gateway1Name, "")
+	ctg := NewServiceCatalog(fake.NewClientBuilder().WithObjects(route).Build(), nil, nil)
+	doTestQueryWithError(t, ctg, *NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace(namespace1).
+		Name(httpRoute1Name).Build(), "", "not found")
+}
+
+func doTestQueryGatewayHTTPRoute(t *testing.T, expectedUri string, objects ...*unstructured.Unstructured) {
+	cli := fake.NewClientBuilder()
+	for _, object := range objects {
+		cli = cli.WithObjects(object)
+	}
+	ctg := NewServiceCatalog(cli.Build(), nil, nil)
+	doTestQuery(t, ctg, *NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace(namespace1).
+		Name(httpRoute1Name).Build(), "", expectedUri)
+}
+
+func Test_QueryGatewayGateway(t *testing.T) {
+	doTestQueryGatewayGateway(t, "", "https://10.1.5.20:8443")
+}
+
+func Test_QueryGatewayGatewayWithPort(t *testing.T) {
+	doTestQueryGatewayGateway(t, "http", "http://10.1.5.20:8080")
+}
+
+func doTestQueryGatewayGateway(t *testing.T, port string, expectedUri string) {
+	gateway := mockGateway(namespace1, gateway1Name, "10.1.5.20",
+		mockGatewayListener("http", "HTTP", 8080), mockGatewayListener("https", "HTTPS", 8443))
+	ctg := NewServiceCatalog(fake.NewClientBuilder().WithObjects(gateway).Build(), nil, nil)
+	doTestQuery(t, ctg, *NewResourceUriBuilder(GatewayScheme).
+		Kind("gateways").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace(namespace1).
+		Name(gateway1Name).
+		WithPort(port).Build(), "", expectedUri)
+}
+
+func Test_QueryIstioVirtualService(t *testing.T) {
+	doTestQueryIstioVirtualService(t, "http", "http://my-service.example.com:80")
+}
+
+func Test_QueryIstioVirtualServiceWithTLS(t *testing.T) {
+	doTestQueryIstioVirtualService(t, "tls", "https://my-service.example.com:443")
+}
+
+func doTestQueryIstioVirtualService(t *testing.T, routeType string, expectedUri string) {
+	virtualService := newUnstructured(istioNetworkingGroup, "v1beta1", "VirtualService")
+	virtualService.SetNamespace(namespace1)
+	virtualService.SetName(virtualService1Name)
+	_ = unstructured.SetNestedStringSlice(virtualService.Object, []string{"*.example.com", "my-service.example.com"}, "spec", "hosts")
+	_ = unstructured.SetNestedSlice(virtualService.Object, []interface{}{map[string]interface{}{}}, "spec", routeType)
+	ctg := NewServiceCatalog(fake.NewClientBuilder().WithObjects(virtualService).Build(), nil, nil)
+	doTestQuery(t, ctg, *NewResourceUriBuilder(IstioScheme).
+		Kind("virtualservices").
+		Group("networking.istio.io").
+		Version("v1beta1").
+		Namespace(namespace1).
+		Name(virtualService1Name).Build(), "", expectedUri)
+}
+
+func Test_QueryIstioServiceEntry(t *testing.T) {
+	doTestQueryIstioServiceEntry(t, "", "https://external.example.com:443")
+}
+
+func Test_QueryIstioServiceEntryWithPort(t *testing.T) {
+	doTestQueryIstioServiceEntry(t, "http-alt", "http://external.example.com:8080")
+}
+
+func doTestQueryIstioServiceEntry(t *testing.T, port string, expectedUri string) {
+	serviceEntry := newUnstructured(istioNetworkingGroup, "v1beta1", "ServiceEntry")
+	serviceEntry.SetNamespace(namespace1)
+	serviceEntry.SetName(serviceEntry1Name)
+	_ = unstructured.SetNestedStringSlice(serviceEntry.Object, []string{"external.example.com"}, "spec", "hosts")
+	_ = unstructured.SetNestedSlice(serviceEntry.Object, []interface{}{
+		map[string]interface{}{"name": "http-alt", "protocol": "HTTP", "number": int64(8080)},
+		map[string]interface{}{"name": "https", "protocol": "HTTPS", "number": int64(443)},
+	}, "spec", "ports")
+	ctg := NewServiceCatalog(fake.NewClientBuilder().WithObjects(serviceEntry).Build(), nil, nil)
+	doTestQuery(t, ctg, *NewResourceUriBuilder(IstioScheme).
+		Kind("serviceentries").
+		Group("networking.istio.io").
+		Version("v1beta1").
+		Namespace(namespace1).
+		Name(serviceEntry1Name).
+		WithPort(port).Build(), "", expectedUri)
+}
+
+func mockHTTPRoute(namespace string, name string, gatewayName string, sectionName string, hostnames ...string) *unstructured.Unstructured {
+	route := newUnstructured(gatewayAPIGroup, "v1", "HTTPRoute")
+	route.SetNamespace(namespace)
+	route.SetName(name)
+	parentRef := map[string]interface{}{"name": gatewayName}
+	if len(sectionName) > 0 {
+		parentRef["sectionName"] = sectionName
+	}
+	_ = unstructured.SetNestedSlice(route.Object, []interface{}{parentRef}, "spec", "parentRefs")
+	if len(hostnames) > 0 {
+		_ = unstructured.SetNestedStringSlice(route.Object, hostnames, "spec", "hostnames")
+	}
+	return route
+}
+
+func mockGateway(namespace string, name string, address string, listeners ...interface{}) *unstructured.Unstructured {
+	gateway := newUnstructured(gatewayAPIGroup, "v1", "Gateway")
+	gateway.SetNamespace(namespace)
+	gateway.SetName(name)
+	_ = unstructured.SetNestedSlice(gateway.Object, listeners, "spec", "listeners")
+	_ = unstructured.SetNestedSlice(gateway.Object, []interface{}{map[string]interface{}{"value": address}}, "status", "addresses")
+	return gateway
+}
+
+func mockGatewayListener(name string, protocol string, port int64) interface{} {
+	return map[string]interface{}{"name": name, "protocol": protocol, "port": port}
+}
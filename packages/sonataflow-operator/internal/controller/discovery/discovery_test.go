@@ -246,6 +246,45 @@ func doTestQueryKubernetesIngress(t *testing.T, hostName string, ip string, tls
 		Name(ingress1Name).Build(), outputFormat, expectedUri)
 }
 
+func Test_GetPodSelectorForService(t *testing.T) {
+	selector := map[string]string{label1: valueLabel1}
+	service := mockService(namespace1, service1Name, nil, &selector)
+	cli := fake.NewClientBuilder().WithRuntimeObjects(service).Build()
+	podSelector, err := GetPodSelector(context.TODO(), cli, *NewResourceUriBuilder(KubernetesScheme).
+		Kind("services").
+		Version("v1").
+		Namespace(namespace1).
+		Name(service1Name).Build())
+	assert.NoError(t, err)
+	assert.Equal(t, selector, podSelector.MatchLabels)
+}
+
+func Test_GetPodSelectorForDeployment(t *testing.T) {
+	selector := map[string]string{label1: valueLabel1, label2: valueLabel2}
+	deployment := mockDeployment(namespace1, deployment1Name, nil, &selector)
+	cli := fake.NewClientBuilder().WithRuntimeObjects(deployment).Build()
+	podSelector, err := GetPodSelector(context.TODO(), cli, *NewResourceUriBuilder(KubernetesScheme).
+		Group("apps").
+		Version("v1").
+		Kind("deployments").
+		Namespace(namespace1).
+		Name(deployment1Name).Build())
+	assert.NoError(t, err)
+	assert.Equal(t, selector, podSelector.MatchLabels)
+}
+
+func Test_GetPodSelectorForIngress(t *testing.T) {
+	cli := fake.NewClientBuilder().WithRuntimeObjects(mockIngress(namespace1, ingress1Name)).Build()
+	podSelector, err := GetPodSelector(context.TODO(), cli, *NewResourceUriBuilder(KubernetesScheme).
+		Group("networking.k8s.io").
+		Version("v1").
+		Kind("ingresses").
+		Namespace(namespace1).
+		Name(ingress1Name).Build())
+	assert.NoError(t, err)
+	assert.Nil(t, podSelector)
+}
+
 func doTestQuery(t *testing.T, ctg ServiceCatalog, resourceUri ResourceUri, outputFormat, expectedUri string) {
 	uri, err := ctg.Query(context.TODO(), resourceUri, outputFormat)
 	assert.NoError(t, err)
@@ -0,0 +1,185 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	gatewayAPIGroup = "gateway.networking.k8s.io"
+	httpRouteKind   = "httproutes"
+	gatewayKind     = "gateways"
+
+	gatewayHTTPSProtocol = "HTTPS"
+	gatewayHTTPProtocol  = "HTTP"
+)
+
+// gatewayServiceCatalog resolves the addresses of Gateway API resources. The resources are read as unstructured
+// content, so the operator doesn't depend on the Gateway API types.
+type gatewayServiceCatalog struct {
+	Client client.Client
+}
+
+func newGatewayServiceCatalog(cli client.Client) gatewayServiceCatalog {
+	return gatewayServiceCatalog{
+		Client: cli,
+	}
+}
+
+func (c gatewayServiceCatalog) Query(ctx context.Context, uri ResourceUri, outputFormat string) (string, error) {
+	switch uri.GVK.Kind {
+	case httpRouteKind:
+		return c.resolveHTTPRouteQuery(ctx, uri)
+	case gatewayKind:
+		return c.resolveGatewayQuery(ctx, uri)
+	default:
+		return "", fmt.Errorf("resolution of gateway kind: %s is not implemented", uri.GVK.Kind)
+	}
+}
+
+// resolveHTTPRouteQuery resolves the HTTPRoute address with its first hostname, or the address of its parent Gateway
+// when no hostname is set. The scheme and port are taken from the parent Gateway listener the route is attached to.
+func (c gatewayServiceCatalog) resolveHTTPRouteQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	route, err := findUnstructured(ctx, c.Client, gatewayAPIGroup, "v1", "HTTPRoute", uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	host := ""
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	for _, hostname := range hostnames {
+		if !strings.HasPrefix(hostname, "*") {
+			host = hostname
+			break
+		}
+	}
+
+	var gateway *unstructured.Unstructured
+	sectionName := ""
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	if len(parentRefs) > 0 {
+		parentRef, _ := parentRefs[0].(map[string]interface{})
+		gatewayName, _, _ := unstructured.NestedString(parentRef, "name")
+		gatewayNamespace, _, _ := unstructured.NestedString(parentRef, "namespace")
+		if len(gatewayNamespace) == 0 {
+			gatewayNamespace = uri.Namespace
+		}
+		sectionName, _, _ = unstructured.NestedString(parentRef, "sectionName")
+		if gateway, err = findUnstructured(ctx, c.Client, gatewayAPIGroup, "v1", "Gateway", gatewayNamespace, gatewayName); err != nil && len(host) == 0 {
+			return "", err
+		}
+	}
+	if len(host) == 0 {
+		if gateway == nil {
+			return "", fmt.Errorf("no hostname nor parent gateway was found for the httproute: %s in namespace: %s", uri.Name, uri.Namespace)
+		}
+		if host = getGatewayAddress(gateway); len(host) == 0 {
+			return "", fmt.Errorf("no address was found for the parent gateway of the httproute: %s in namespace: %s", uri.Name, uri.Namespace)
+		}
+	}
+	scheme, port := httpProtocol, defaultHttpPort
+	if gateway != nil {
+		if listener := findBestSuitedGatewayListener(gateway, sectionName); listener != nil {
+			scheme, port = getGatewayListenerSchemeAndPort(listener)
+		}
+	}
+	return buildURI(scheme, host, port), nil
+}
+
+// resolveGatewayQuery resolves the Gateway address with the first address assigned to it. The optional port query
+// param selects the listener by name, otherwise HTTPS listeners are preferred over HTTP ones.
+func (c gatewayServiceCatalog) resolveGatewayQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	gateway, err := findUnstructured(ctx, c.Client, gatewayAPIGroup, "v1", "Gateway", uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	host := getGatewayAddress(gateway)
+	if len(host) == 0 {
+		return "", fmt.Errorf("no address was found for the gateway: %s in namespace: %s", uri.Name, uri.Namespace)
+	}
+	listener := findBestSuitedGatewayListener(gateway, uri.GetPort())
+	if listener == nil {
+		return "", fmt.Errorf("no http listener was found for the gateway: %s in namespace: %s", uri.Name, uri.Namespace)
+	}
+	scheme, port := getGatewayListenerSchemeAndPort(listener)
+	return buildURI(scheme, host, port), nil
+}
+
+func getGatewayAddress(gateway *unstructured.Unstructured) string {
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	for _, address := range addresses {
+		if value, _, _ := unstructured.NestedString(address.(map[string]interface{}), "value"); len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+// findBestSuitedGatewayListener returns the listener with the given name if set, or the first HTTPS listener, or the
+// first HTTP listener. Returns nil if no listener matches.
+func findBestSuitedGatewayListener(gateway *unstructured.Unstructured, name string) map[string]interface{} {
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	if len(name) > 0 {
+		for _, listener := range listeners {
+			if listenerName, _, _ := unstructured.NestedString(listener.(map[string]interface{}), "name"); listenerName == name {
+				return listener.(map[string]interface{})
+			}
+		}
+		return nil
+	}
+	for _, protocol := range []string{gatewayHTTPSProtocol, gatewayHTTPProtocol} {
+		for _, listener := range listeners {
+			if listenerProtocol, _, _ := unstructured.NestedString(listener.(map[string]interface{}), "protocol"); listenerProtocol == protocol {
+				return listener.(map[string]interface{})
+			}
+		}
+	}
+	return nil
+}
+
+func getGatewayListenerSchemeAndPort(listener map[string]interface{}) (string, int) {
+	scheme := httpProtocol
+	if protocol, _, _ := unstructured.NestedString(listener, "protocol"); protocol == gatewayHTTPSProtocol {
+		scheme = httpsProtocol
+	}
+	port, found, _ := unstructured.NestedInt64(listener, "port")
+	if !found {
+		if scheme == httpsProtocol {
+			return scheme, defaultHttpsPort
+		}
+		return scheme, defaultHttpPort
+	}
+	return scheme, int(port)
+}
+
+// findUnstructured finds a resource by name in the given namespace as unstructured content.
+func findUnstructured(ctx context.Context, cli client.Client, group, version, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	object := newUnstructured(group, version, kind)
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
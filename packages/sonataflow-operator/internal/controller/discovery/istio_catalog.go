@@ -0,0 +1,161 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
+)
+
+const (
+	istioNetworkingGroup = "networking.istio.io"
+	virtualServiceKind   = "virtualservices"
+	serviceEntryKind     = "serviceentries"
+
+	istioHTTPSProtocol = "HTTPS"
+	istioTLSProtocol   = "TLS"
+	istioHTTPProtocol  = "HTTP"
+)
+
+// GetIstioAvailability returns true if the Istio networking api is installed in the cluster.
+func GetIstioAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == istioNetworkingGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// istioServiceCatalog resolves the addresses of Istio networking resources. The resources are read as unstructured
+// content, so the operator doesn't depend on the Istio types.
+type istioServiceCatalog struct {
+	Client client.Client
+}
+
+func newIstioServiceCatalog(cli client.Client) istioServiceCatalog {
+	return istioServiceCatalog{
+		Client: cli,
+	}
+}
+
+func (c istioServiceCatalog) Query(ctx context.Context, uri ResourceUri, outputFormat string) (string, error) {
+	switch uri.GVK.Kind {
+	case virtualServiceKind:
+		return c.resolveVirtualServiceQuery(ctx, uri)
+	case serviceEntryKind:
+		return c.resolveServiceEntryQuery(ctx, uri)
+	default:
+		return "", fmt.Errorf("resolution of istio kind: %s is not implemented", uri.GVK.Kind)
+	}
+}
+
+// resolveVirtualServiceQuery resolves the VirtualService address with its first host. VirtualServices routing TLS
+// traffic only are resolved with the https scheme.
+func (c istioServiceCatalog) resolveVirtualServiceQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	virtualService, err := findUnstructured(ctx, c.Client, istioNetworkingGroup, uri.GVK.Version, "VirtualService", uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	host := findFirstIstioHost(virtualService)
+	if len(host) == 0 {
+		return "", fmt.Errorf("no host was found for the virtualservice: %s in namespace: %s", uri.Name, uri.Namespace)
+	}
+	httpRoutes, _, _ := unstructured.NestedSlice(virtualService.Object, "spec", "http")
+	tlsRoutes, _, _ := unstructured.NestedSlice(virtualService.Object, "spec", "tls")
+	if len(httpRoutes) == 0 && len(tlsRoutes) > 0 {
+		return buildURI(httpsProtocol, host, defaultHttpsPort), nil
+	}
+	return buildURI(httpProtocol, host, defaultHttpPort), nil
+}
+
+// resolveServiceEntryQuery resolves the ServiceEntry address with its first host. The optional port query param
+// selects the port by name, otherwise HTTPS ports are preferred over HTTP ones.
+func (c istioServiceCatalog) resolveServiceEntryQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	serviceEntry, err := findUnstructured(ctx, c.Client, istioNetworkingGroup, uri.GVK.Version, "ServiceEntry", uri.Namespace, uri.Name)
+	if err != nil {
+		return "", err
+	}
+	host := findFirstIstioHost(serviceEntry)
+	if len(host) == 0 {
+		return "", fmt.Errorf("no host was found for the serviceentry: %s in namespace: %s", uri.Name, uri.Namespace)
+	}
+	port := findBestSuitedServiceEntryPort(serviceEntry, uri.GetPort())
+	if port == nil {
+		return "", fmt.Errorf("no port was found for the serviceentry: %s in namespace: %s", uri.Name, uri.Namespace)
+	}
+	scheme := httpProtocol
+	protocol, _, _ := unstructured.NestedString(port, "protocol")
+	if protocol == istioHTTPSProtocol || protocol == istioTLSProtocol {
+		scheme = httpsProtocol
+	}
+	number, _, _ := unstructured.NestedInt64(port, "number")
+	return buildURI(scheme, host, int(number)), nil
+}
+
+func findFirstIstioHost(object *unstructured.Unstructured) string {
+	hosts, _, _ := unstructured.NestedStringSlice(object.Object, "spec", "hosts")
+	for _, host := range hosts {
+		if !strings.HasPrefix(host, "*") {
+			return host
+		}
+	}
+	return ""
+}
+
+// findBestSuitedServiceEntryPort returns the port with the given name if set, or the first HTTPS port, or the first
+// HTTP port, or the first port. Returns nil if no port matches.
+func findBestSuitedServiceEntryPort(serviceEntry *unstructured.Unstructured, name string) map[string]interface{} {
+	ports, _, _ := unstructured.NestedSlice(serviceEntry.Object, "spec", "ports")
+	if len(name) > 0 {
+		for _, port := range ports {
+			if portName, _, _ := unstructured.NestedString(port.(map[string]interface{}), "name"); portName == name {
+				return port.(map[string]interface{})
+			}
+		}
+		return nil
+	}
+	for _, protocol := range []string{istioHTTPSProtocol, istioHTTPProtocol} {
+		for _, port := range ports {
+			if portProtocol, _, _ := unstructured.NestedString(port.(map[string]interface{}), "protocol"); portProtocol == protocol {
+				return port.(map[string]interface{})
+			}
+		}
+	}
+	if len(ports) > 0 {
+		return ports[0].(map[string]interface{})
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -136,3 +137,38 @@ func (c k8sServiceCatalog) resolveIngressQuery(ctx context.Context, uri Resource
 		return buildURI(scheme, host, port), nil
 	}
 }
+
+// GetPodSelector returns the selector of the pods serving the kubernetes resource pointed by the given uri.
+// Returns nil if the uri doesn't point at a Service, Pod, Deployment or StatefulSet, or the resource selects no pods.
+func GetPodSelector(ctx context.Context, cli client.Client, uri ResourceUri) (*metav1.LabelSelector, error) {
+	if uri.Scheme != KubernetesScheme {
+		return nil, nil
+	}
+	switch uri.GVK.Kind {
+	case serviceKind:
+		if service, err := findService(ctx, cli, uri.Namespace, uri.Name); err != nil {
+			return nil, err
+		} else if len(service.Spec.Selector) > 0 {
+			return &metav1.LabelSelector{MatchLabels: service.Spec.Selector}, nil
+		}
+	case podKind:
+		if pod, err := findPod(ctx, cli, uri.Namespace, uri.Name); err != nil {
+			return nil, err
+		} else if len(pod.Labels) > 0 {
+			return &metav1.LabelSelector{MatchLabels: pod.Labels}, nil
+		}
+	case deploymentKind:
+		if deployment, err := findDeployment(ctx, cli, uri.Namespace, uri.Name); err != nil {
+			return nil, err
+		} else {
+			return deployment.Spec.Selector, nil
+		}
+	case statefulSetKind:
+		if statefulSet, err := findStatefulSet(ctx, cli, uri.Namespace, uri.Name); err != nil {
+			return nil, err
+		} else {
+			return statefulSet.Spec.Selector, nil
+		}
+	}
+	return nil, nil
+}
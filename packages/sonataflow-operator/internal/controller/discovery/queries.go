@@ -152,3 +152,21 @@ func findIngress(ctx context.Context, cli client.Client, namespace string, name
 	}
 	return ingress, nil
 }
+
+// findConfigMap finds a configmap by name in the given namespace.
+func findConfigMap(ctx context.Context, cli client.Client, namespace string, name string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, configMap); err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+// findSecret finds a secret by name in the given namespace.
+func findSecret(ctx context.Context, cli client.Client, namespace string, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// trackedResource identifies a resource the address of a workflow service discovery uri is resolved from.
+// An empty name stands for any resource of the given kind in the namespace.
+type trackedResource struct {
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+// resourceTracker keeps, for every workflow, the resources its service discovery uris point at.
+type resourceTracker struct {
+	mutex     sync.RWMutex
+	workflows map[types.NamespacedName]map[trackedResource]bool
+}
+
+// unstructuredKinds maps the kinds read as unstructured content to the resource names used in the discovery uris.
+var unstructuredKinds = map[schema.GroupKind]string{
+	{Group: gatewayAPIGroup, Kind: "HTTPRoute"}:           httpRouteKind,
+	{Group: gatewayAPIGroup, Kind: "Gateway"}:             gatewayKind,
+	{Group: istioNetworkingGroup, Kind: "VirtualService"}: virtualServiceKind,
+	{Group: istioNetworkingGroup, Kind: "ServiceEntry"}:   serviceEntryKind,
+}
+
+var tracker = &resourceTracker{workflows: map[types.NamespacedName]map[trackedResource]bool{}}
+
+// TrackWorkflowResources records the resources pointed by the given service discovery uris as the ones the workflow
+// addresses are resolved from, replacing the previously recorded ones.
+func TrackWorkflowResources(workflow types.NamespacedName, uris []ResourceUri) {
+	if len(uris) == 0 {
+		UntrackWorkflow(workflow)
+		return
+	}
+	resources := make(map[trackedResource]bool, len(uris))
+	for _, uri := range uris {
+		for _, resource := range resolvedFrom(uri) {
+			resources[resource] = true
+		}
+	}
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.workflows[workflow] = resources
+}
+
+// UntrackWorkflow forgets the resources recorded for the given workflow.
+func UntrackWorkflow(workflow types.NamespacedName) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	delete(tracker.workflows, workflow)
+}
+
+// GetWorkflowsResolvedFrom returns the workflows having service discovery uris resolved from the given object.
+func GetWorkflowsResolvedFrom(object client.Object) []types.NamespacedName {
+	resource, ok := trackedResourceOf(object)
+	if !ok {
+		return nil
+	}
+	anyResource := resource
+	anyResource.name = ""
+
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	var workflows []types.NamespacedName
+	for workflow, resources := range tracker.workflows {
+		if resources[resource] || resources[anyResource] {
+			workflows = append(workflows, workflow)
+		}
+	}
+	return workflows
+}
+
+// IsResolvedFrom returns true if any workflow has service discovery uris resolved from the given object.
+func IsResolvedFrom(object client.Object) bool {
+	return len(GetWorkflowsResolvedFrom(object)) > 0
+}
+
+// GetGatewayAPIResources returns the Gateway API resources service discovery uris can be resolved from.
+func GetGatewayAPIResources() []client.Object {
+	return []client.Object{
+		newUnstructured(gatewayAPIGroup, "v1", "HTTPRoute"),
+		newUnstructured(gatewayAPIGroup, "v1", "Gateway"),
+	}
+}
+
+// GetIstioResources returns the Istio resources service discovery uris can be resolved from.
+func GetIstioResources() []client.Object {
+	return []client.Object{
+		newUnstructured(istioNetworkingGroup, "v1beta1", "VirtualService"),
+		newUnstructured(istioNetworkingGroup, "v1beta1", "ServiceEntry"),
+	}
+}
+
+func newUnstructured(group, version, kind string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+	return object
+}
+
+// resolvedFrom returns the resources the address of the given uri is calculated from. Pods, Deployments, StatefulSets
+// and DeploymentConfigs are resolved from the Services selecting them, so any Service in the namespace can change it.
+func resolvedFrom(uri ResourceUri) []trackedResource {
+	resource := trackedResource{group: uri.GVK.Group, kind: uri.GVK.Kind, namespace: uri.Namespace, name: uri.Name}
+	switch {
+	case uri.Scheme == KubernetesScheme && (uri.GVK.Kind == podKind || uri.GVK.Kind == deploymentKind || uri.GVK.Kind == statefulSetKind),
+		uri.Scheme == OpenshiftScheme && uri.GVK.Kind == openShiftDeploymentConfigs:
+		return []trackedResource{resource, {kind: serviceKind, namespace: uri.Namespace}}
+	default:
+		return []trackedResource{resource}
+	}
+}
+
+func trackedResourceOf(object client.Object) (trackedResource, bool) {
+	resource := trackedResource{namespace: object.GetNamespace(), name: object.GetName()}
+	switch object.(type) {
+	case *corev1.Service:
+		resource.kind = serviceKind
+	case *corev1.ConfigMap:
+		resource.kind = configMapKind
+	case *corev1.Secret:
+		resource.kind = secretKind
+	case *metav1.PartialObjectMetadata:
+		// Secrets are watched by their metadata only, their content isn't cached
+		if object.GetObjectKind().GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: "Secret"}) {
+			return resource, false
+		}
+		resource.kind = secretKind
+	case *networkingv1.Ingress:
+		resource.group, resource.kind = networkingv1.GroupName, ingressKind
+	case *servingv1.Service:
+		resource.group, resource.kind = servingv1.SchemeGroupVersion.Group, knServiceKind
+	case *eventingv1.Broker:
+		resource.group, resource.kind = eventingv1.SchemeGroupVersion.Group, knBrokerKind
+	case *unstructured.Unstructured:
+		gvk := object.GetObjectKind().GroupVersionKind()
+		kind, ok := unstructuredKinds[gvk.GroupKind()]
+		if !ok {
+			return resource, false
+		}
+		resource.group, resource.kind = gvk.Group, kind
+	default:
+		return resource, false
+	}
+	return resource, true
+}
@@ -0,0 +1,68 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_TrackWorkflowResources(t *testing.T) {
+	workflow := types.NamespacedName{Name: "greeting", Namespace: namespace1}
+	serviceUri := NewResourceUriBuilder(KubernetesScheme).Kind(serviceKind).Version("v1").Namespace(namespace1).Name(service1Name).Build()
+	deploymentUri := NewResourceUriBuilder(KubernetesScheme).Kind(deploymentKind).Group("apps").Version("v1").Namespace("namespace2").Name("my-deployment").Build()
+	TrackWorkflowResources(workflow, []ResourceUri{*serviceUri, *deploymentUri})
+	defer UntrackWorkflow(workflow)
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: service1Name, Namespace: namespace1}}
+	assert.Equal(t, []types.NamespacedName{workflow}, GetWorkflowsResolvedFrom(service))
+	// the deployment address is resolved from any Service selecting its pods
+	assert.True(t, IsResolvedFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "any", Namespace: "namespace2"}}))
+	assert.False(t, IsResolvedFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "any", Namespace: namespace1}}))
+	assert.False(t, IsResolvedFrom(&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: service1Name, Namespace: namespace1}}))
+
+	TrackWorkflowResources(workflow, nil)
+	assert.Empty(t, GetWorkflowsResolvedFrom(service))
+}
+
+func Test_TrackWorkflowConfigResources(t *testing.T) {
+	workflow := types.NamespacedName{Name: "greeting", Namespace: namespace1}
+	configMapUri := NewResourceUriBuilder(ConfigScheme).Kind(configMapKind).Version("v1").Namespace(namespace1).Name("endpoints").WithQueryParam(KeyQueryParam, "billing").Build()
+	TrackWorkflowResources(workflow, []ResourceUri{*configMapUri})
+	defer UntrackWorkflow(workflow)
+
+	assert.Equal(t, []types.NamespacedName{workflow}, GetWorkflowsResolvedFrom(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "endpoints", Namespace: namespace1}}))
+	assert.False(t, IsResolvedFrom(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "endpoints", Namespace: namespace1}}))
+	assert.False(t, IsResolvedFrom(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace1}}))
+
+	secretUri := NewResourceUriBuilder(ConfigScheme).Kind(secretKind).Version("v1").Namespace(namespace1).Name("credentials").WithQueryParam(KeyQueryParam, "billing").Build()
+	TrackWorkflowResources(workflow, []ResourceUri{*secretUri})
+	secretMetadata := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: namespace1}}
+	secretMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	assert.Equal(t, []types.NamespacedName{workflow}, GetWorkflowsResolvedFrom(secretMetadata))
+	configMapMetadata := secretMetadata.DeepCopy()
+	configMapMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	assert.False(t, IsResolvedFrom(configMapMetadata))
+}
@@ -66,8 +66,12 @@ func GetWorkflowDefinitionEventsTargetURL(cli client.Client, workflow *operatora
 			uri = sinkURI.String()
 		}
 	} else {
-		// Workflow is connected via direct http invocation with the DI.
-		uri = diHandler.GetServiceBaseUrl() + constants.KogitoProcessDefinitionsEventsPath
+		// Workflow is connected via direct http invocation with the DI, the operator doesn't trust the platform CA.
+		baseUrl := diHandler.GetServiceBaseUrl()
+		if diHandler.IsServiceEnabledInSpec() {
+			baseUrl = diHandler.GetRemoteServiceBaseUrl()
+		}
+		uri = baseUrl + constants.KogitoProcessDefinitionsEventsPath
 	}
 	return uri, nil
 }
@@ -43,6 +43,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/eventing"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
@@ -54,6 +55,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
 	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
@@ -206,8 +208,10 @@ func IsKafkaBroker(brokerClass string) bool {
 	return strings.Contains(brokerClass, "Kafka")
 }
 
+// GetWorkflowSink returns the destination of the events produced by the workflow, nil if the workflow produces its
+// events with Kafka topics instead.
 func GetWorkflowSink(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (*duckv1.Destination, error) {
-	if workflow == nil {
+	if workflow == nil || kafka.IsEnabled(workflow, pl) {
 		return nil, nil
 	}
 	if workflow.Spec.Sink != nil {
@@ -231,6 +235,26 @@ func GetWorkflowSink(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlo
 	return nil, nil
 }
 
+// GetDeliverySpec converts the given SonataFlow event delivery into the Knative delivery of a Trigger.
+// The dead letter sink reference defaults to the given namespace.
+func GetDeliverySpec(delivery *operatorapi.EventDeliverySpec, namespace string) *eventingduckv1.DeliverySpec {
+	if delivery == nil {
+		return nil
+	}
+	spec := &eventingduckv1.DeliverySpec{
+		Retry:        delivery.Retry,
+		BackoffDelay: delivery.BackoffDelay,
+	}
+	if delivery.DeadLetterSink != nil {
+		spec.DeadLetterSink = getDestinationWithNamespace(delivery.DeadLetterSink.DeepCopy(), namespace)
+	}
+	if delivery.BackoffPolicy != nil {
+		policy := eventingduckv1.BackoffPolicyType(*delivery.BackoffPolicy)
+		spec.BackoffPolicy = &policy
+	}
+	return spec
+}
+
 func IsKnativeBroker(kRef *duckv1.KReference) bool {
 	return kRef.APIVersion == knativeEventingAPIVersion && kRef.Kind == knativeBrokerKind
 }
@@ -409,6 +433,10 @@ func CleanupOutdatedRevisions(ctx context.Context, cfg *rest.Config, workflow *o
 	// Clean up previous revisions that do not have K_SINK injected
 	for i := 0; i < len(revisionList.Items)-1; i++ {
 		revision := &revisionList.Items[i]
+		if isRolloutRevision(workflow, revision.Name) {
+			// the traffic is still routed to this revision
+			continue
+		}
 		if !containsKSink(revision) {
 			klog.V(log.I).InfoS("Revision %s does not have K_SINK injected and can be cleaned up.", revision.Name)
 			if err := utils.GetClient().Delete(ctx, revision, &client.DeleteOptions{}); err != nil {
@@ -419,6 +447,11 @@ func CleanupOutdatedRevisions(ctx context.Context, cfg *rest.Config, workflow *o
 	return nil
 }
 
+func isRolloutRevision(workflow *operatorapi.SonataFlow, revisionName string) bool {
+	rollout := workflow.Status.Rollout
+	return rollout != nil && (rollout.StableRevision == revisionName || rollout.CanaryRevision == revisionName)
+}
+
 func containsKSink(revision *servingv1.Revision) bool {
 	for _, container := range revision.Spec.PodSpec.Containers {
 		if container.Name == workflowContainer {
@@ -0,0 +1,34 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	v08 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// BuildCacheOption is the build strategy option enabling the reuse of the images built from the same workflow contents.
+const BuildCacheOption = "BuildCacheEnabled"
+
+// IsBuildCacheEnabled tells whether the workflow builds are cached in the platform. The `platform` build strategy pushes
+// to ImageStreams that aren't content addressed, so it's never cached.
+func IsBuildCacheEnabled(platform *v08.SonataFlowPlatform) bool {
+	return platform.Spec.Build.Config.BuildStrategy != v08.PlatformBuildStrategy &&
+		platform.Spec.Build.Config.IsStrategyOptionEnabled(BuildCacheOption)
+}
@@ -0,0 +1,57 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	v08 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+)
+
+// ContainerBuilderOption is the build strategy option selecting the tool building the workflow images with the
+// `operator` build strategy.
+const ContainerBuilderOption = "ContainerBuilder"
+
+// SupportedContainerBuilders are the tools the `operator` build strategy is able to build the workflow images with.
+var SupportedContainerBuilders = []api.PlatformContainerBuildPublishStrategy{
+	api.PlatformBuildPublishStrategyKaniko,
+	api.PlatformBuildPublishStrategyBuildah,
+	api.PlatformBuildPublishStrategyBuildKit,
+}
+
+// GetContainerBuilder returns the tool building the workflow images in the platform, Kaniko by default.
+func GetContainerBuilder(platform *v08.SonataFlowPlatform) api.PlatformContainerBuildPublishStrategy {
+	if builder, ok := platform.Spec.Build.Config.BuildStrategyOptions[ContainerBuilderOption]; ok && len(builder) > 0 {
+		return api.PlatformContainerBuildPublishStrategy(builder)
+	}
+	return api.PlatformBuildPublishStrategyKaniko
+}
+
+// GetContainerBuilderImage returns the image of the pods building the workflow images with the given tool.
+func GetContainerBuilderImage(builder api.PlatformContainerBuildPublishStrategy) string {
+	switch builder {
+	case api.PlatformBuildPublishStrategyBuildah:
+		return cfg.GetCfg().BuildahImageTag
+	case api.PlatformBuildPublishStrategyBuildKit:
+		return cfg.GetCfg().BuildKitImageTag
+	default:
+		return cfg.GetCfg().KanikoExecutorImageTag
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestGetContainerBuilder(t *testing.T) {
+	plf := test.GetBasePlatform()
+	plf.Spec.Build.Config.BuildStrategyOptions = map[string]string{kanikoBuildCacheEnabled: "true"}
+	assert.Equal(t, api.PlatformBuildPublishStrategyKaniko, GetContainerBuilder(plf))
+	assert.Equal(t, cfg.GetCfg().KanikoExecutorImageTag, GetContainerBuilderImage(GetContainerBuilder(plf)))
+	assert.True(t, IsKanikoCacheEnabled(plf))
+
+	plf.Spec.Build.Config.BuildStrategyOptions[ContainerBuilderOption] = string(api.PlatformBuildPublishStrategyBuildah)
+	assert.Equal(t, api.PlatformBuildPublishStrategyBuildah, GetContainerBuilder(plf))
+	assert.Equal(t, cfg.GetCfg().BuildahImageTag, GetContainerBuilderImage(GetContainerBuilder(plf)))
+	// the Kaniko cache doesn't apply to the other builders
+	assert.False(t, IsKanikoCacheEnabled(plf))
+
+	plf.Spec.Build.Config.BuildStrategyOptions[ContainerBuilderOption] = string(api.PlatformBuildPublishStrategyBuildKit)
+	assert.Equal(t, api.PlatformBuildPublishStrategyBuildKit, GetContainerBuilder(plf))
+	assert.Equal(t, cfg.GetCfg().BuildKitImageTag, GetContainerBuilderImage(GetContainerBuilder(plf)))
+}
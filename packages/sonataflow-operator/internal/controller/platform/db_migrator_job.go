@@ -21,6 +21,8 @@ package platform
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -32,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
@@ -54,28 +57,43 @@ type QuarkusDataSource struct {
 type DBMigratorJob struct {
 	MigrateDBDataIndex    bool
 	DataIndexDataSource   *QuarkusDataSource
+	DryRunDataIndex       bool
 	MigrateDBJobsService  bool
 	JobsServiceDataSource *QuarkusDataSource
+	DryRunJobsService     bool
+	BackoffLimit          int32
 }
 
 type DBMigratorJobStatus struct {
 	Name           string
 	BatchJobStatus *batchv1.JobStatus
+	BackoffLimit   int32
 }
 
+// dbMigrationReport is the summary of the migrations the DB migrator tool writes as JSON in its termination message.
+type dbMigrationReport struct {
+	DataIndex   *operatorapi.DBMigrationServiceStatus `json:"dataIndex,omitempty"`
+	JobsService *operatorapi.DBMigrationServiceStatus `json:"jobsService,omitempty"`
+}
+
+// errDBMigrationJobPending signals that an outdated DB migration job is being removed before creating the new one.
+var errDBMigrationJobPending = errors.New("outdated DB migration job is being deleted")
+
 const (
-	dbMigrationJobName       = "sonataflow-db-migrator-job"
-	dbMigrationContainerName = "db-migration-container"
-	dbMigrationJobFailed     = 1
-	dbMigrationJobSucceeded  = 1
+	dbMigrationJobName           = "sonataflow-db-migrator-job"
+	dbMigrationContainerName     = "db-migration-container"
+	dbMigrationJobSucceeded      = 1
+	dbMigrationJobHashAnnotation = "sonataflow.org/db-migration-hash"
 
 	migrateDBDataIndex                 = "MIGRATE_DB_DATAINDEX"
+	dryRunDBDataIndex                  = "DRY_RUN_DB_DATAINDEX"
 	quarkusDataSourceDataIndexJdbcURL  = "QUARKUS_DATASOURCE_DATAINDEX_JDBC_URL"
 	quarkusDataSourceDataIndexUserName = "QUARKUS_DATASOURCE_DATAINDEX_USERNAME"
 	quarkusDataSourceDataIndexPassword = "QUARKUS_DATASOURCE_DATAINDEX_PASSWORD"
 	quarkusFlywayDataIndexSchemas      = "QUARKUS_FLYWAY_DATAINDEX_SCHEMAS"
 
 	migrateDBJobsService                 = "MIGRATE_DB_JOBSSERVICE"
+	dryRunDBJobsService                  = "DRY_RUN_DB_JOBSSERVICE"
 	quarkusDataSourceJobsServiceJdbcURL  = "QUARKUS_DATASOURCE_JOBSSERVICE_JDBC_URL"
 	quarkusDataSourceJobsServiceUserName = "QUARKUS_DATASOURCE_JOBSSERVICE_USERNAME"
 	quarkusDataSourceJobsServicePassword = "QUARKUS_DATASOURCE_JOBSSERVICE_PASSWORD"
@@ -99,44 +117,19 @@ func getJdbcUrl(env []corev1.EnvVar) string {
 	return ""
 }
 
-// getQuarkusDSFromServicePersistence Returns QuarkusDataSource from service level persistence config
-func getQuarkusDSFromServicePersistence(platform *operatorapi.SonataFlowPlatform, persistenceOptionsSpec *operatorapi.PersistenceOptionsSpec, defaultSchemaName string) *QuarkusDataSource {
-	klog.InfoS("Using service level persistence for PostgreSQL", "defaultSchemaName", defaultSchemaName)
-	quarkusDataSource := &QuarkusDataSource{}
-	env := persistence.ConfigurePostgreSQLEnv(persistenceOptionsSpec.PostgreSQL, defaultSchemaName, platform.Namespace, false)
-	quarkusDataSource.JdbcUrl = getJdbcUrl(env)
-	quarkusDataSource.SecretRefName = persistenceOptionsSpec.PostgreSQL.SecretRef.Name
-	quarkusDataSource.SecretUserKey = persistenceOptionsSpec.PostgreSQL.SecretRef.UserKey
-	quarkusDataSource.SecretPasswordKey = persistenceOptionsSpec.PostgreSQL.SecretRef.PasswordKey
-	quarkusDataSource.Schema = persistence.GetDBSchemaName(persistenceOptionsSpec.PostgreSQL, defaultSchemaName)
-	return quarkusDataSource
-}
-
-// getQuarkusDSFromPlatformPersistence Returns QuarkusDataSource from platform level persistence config
-func getQuarkusDSFromPlatformPersistence(platform *operatorapi.SonataFlowPlatform, defaultSchemaName string) *QuarkusDataSource {
-	klog.InfoS("Using platform level persistence for PostgreSQL", "defaultSchemaName", defaultSchemaName)
-	quarkusDataSource := &QuarkusDataSource{}
-	postgresql := persistence.MapToPersistencePostgreSQL(platform, defaultSchemaName)
-
-	env := persistence.ConfigurePostgreSQLEnv(postgresql, defaultSchemaName, platform.Namespace, false)
-	quarkusDataSource.JdbcUrl = getJdbcUrl(env)
-	quarkusDataSource.SecretRefName = platform.Spec.Persistence.PostgreSQL.SecretRef.Name
-	quarkusDataSource.SecretUserKey = platform.Spec.Persistence.PostgreSQL.SecretRef.UserKey
-	quarkusDataSource.SecretPasswordKey = platform.Spec.Persistence.PostgreSQL.SecretRef.PasswordKey
-	quarkusDataSource.Schema = persistence.GetDBSchemaName(postgresql, defaultSchemaName)
-	return quarkusDataSource
-}
-
-// getQuarkusDataSourceFromPersistence PostgreSQL persistence can be defined at platform level (where both DI and JS will use the same DB defined at platform level) or db can defined at Service level. Service level config will take precedence over platform level config.
+// getQuarkusDataSourceFromPersistence Persistence can be defined at platform level (where both DI and JS will use the same DB defined at platform level) or db can defined at Service level. Service level config will take precedence over platform level config.
 func getQuarkusDataSourceFromPersistence(platform *operatorapi.SonataFlowPlatform, persistenceOptionsSpec *operatorapi.PersistenceOptionsSpec, defaultSchemaName string) *QuarkusDataSource {
-
-	if persistenceOptionsSpec != nil && persistenceOptionsSpec.PostgreSQL != nil {
-		return getQuarkusDSFromServicePersistence(platform, persistenceOptionsSpec, defaultSchemaName)
-	} else if platform != nil && platform.Spec.Persistence != nil && platform.Spec.Persistence.PostgreSQL != nil {
-		return getQuarkusDSFromPlatformPersistence(platform, defaultSchemaName)
+	var platformPersistence *operatorapi.PlatformPersistenceOptionsSpec
+	if platform != nil {
+		platformPersistence = platform.Spec.Persistence
 	}
-
-	return nil
+	provider := persistence.ResolveServiceProvider(persistenceOptionsSpec, platformPersistence, defaultSchemaName)
+	if provider == nil {
+		return nil
+	}
+	klog.InfoS("Using persistence for DB migration", "type", provider.GetType(), "defaultSchemaName", defaultSchemaName)
+	secretName, userKey, passwordKey := provider.GetSecretRef()
+	return newQuarkusDataSource(provider.GetJdbcUrl(defaultSchemaName, platform.Namespace), secretName, userKey, passwordKey, provider.GetSchemaName(defaultSchemaName))
 }
 
 func NewDBMigratorJobData(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, pshDI services.PlatformServiceHandler, pshJS services.PlatformServiceHandler) *DBMigratorJob {
@@ -163,12 +156,24 @@ func NewDBMigratorJobData(ctx context.Context, client client.Client, platform *o
 			quarkusDataSourceJobService = getQuarkusDataSourceFromPersistence(platform, platform.Spec.Services.JobService.Persistence, pshJS.GetServiceName())
 		}
 
-		return &DBMigratorJob{
+		dbMigratorJob := &DBMigratorJob{
 			MigrateDBDataIndex:    diJobsBasedDBMigration,
 			DataIndexDataSource:   quarkusDataSourceDataIndex,
 			MigrateDBJobsService:  jsJobsBasedDBMigration,
 			JobsServiceDataSource: quarkusDataSourceJobService,
 		}
+		// a single job migrates both services, so it retries as much as the most tolerant one requires
+		if diJobsBasedDBMigration {
+			dbMigratorJob.DryRunDataIndex = services.IsDBMigrationDryRun(platform.Spec.Services.DataIndex.Persistence)
+			dbMigratorJob.BackoffLimit = services.GetDBMigrationBackoffLimit(platform.Spec.Services.DataIndex.Persistence)
+		}
+		if jsJobsBasedDBMigration {
+			dbMigratorJob.DryRunJobsService = services.IsDBMigrationDryRun(platform.Spec.Services.JobService.Persistence)
+			if backoffLimit := services.GetDBMigrationBackoffLimit(platform.Spec.Services.JobService.Persistence); backoffLimit > dbMigratorJob.BackoffLimit {
+				dbMigratorJob.BackoffLimit = backoffLimit
+			}
+		}
+		return dbMigratorJob
 	}
 	return nil
 }
@@ -194,6 +199,27 @@ func createOrUpdateDBMigrationJob(ctx context.Context, client client.Client, pla
 	// Invoke DB Migration only if both or either DI/JS services are requested, in addition to DBMigrationStrategyJob
 	if dbMigratorJob != nil {
 		job := createJobDBMigration(platform, dbMigratorJob)
+		hash, err := getDBMigrationJobHash(job)
+		if err != nil {
+			return nil, err
+		}
+		// the job template is immutable, so a new job replaces the existing one when the migration settings change
+		existing := &batchv1.Job{}
+		if err = client.Get(ctx, ctrl.ObjectKeyFromObject(job), existing); err == nil {
+			if existing.DeletionTimestamp != nil {
+				return dbMigratorJob, errDBMigrationJobPending
+			}
+			if existing.Annotations[dbMigrationJobHashAnnotation] != hash {
+				klog.V(log.I).InfoS("Replacing the outdated DB Migration Job", "namespace", platform.Namespace, "job", job.Name)
+				if err = client.Delete(ctx, existing, ctrl.PropagationPolicy(metav1.DeletePropagationBackground)); ctrl.IgnoreNotFound(err) != nil {
+					return dbMigratorJob, err
+				}
+				return dbMigratorJob, errDBMigrationJobPending
+			}
+		} else if ctrl.IgnoreNotFound(err) != nil {
+			return dbMigratorJob, err
+		}
+		job.Annotations = map[string]string{dbMigrationJobHashAnnotation: hash}
 		klog.V(log.I).InfoS("Starting DB Migration Job: ", "namespace", platform.Namespace, "job", job.Name)
 		if err := controllerutil.SetControllerReference(platform, job, client.Scheme()); err != nil {
 			return nil, err
@@ -213,23 +239,26 @@ func createOrUpdateDBMigrationJob(ctx context.Context, client client.Client, pla
 func HandleDBMigrationJob(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psDI services.PlatformServiceHandler, psJS services.PlatformServiceHandler) (*operatorapi.SonataFlowPlatform, error) {
 
 	dbMigratorJob, err := createOrUpdateDBMigrationJob(ctx, client, platform, psDI, psJS)
-	if err != nil {
+	if errors.Is(err, errDBMigrationJobPending) {
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 	if dbMigratorJob != nil {
 		klog.V(log.E).InfoS("Created DB migration job")
 		dbMigratorJobStatus, err := dbMigratorJob.ReconcileDBMigrationJob(ctx, client, platform)
+		if err == nil && hasSucceeded(dbMigratorJobStatus) {
+			return platform, nil
+		}
+		// the platform is only returned once the migration succeeds, so the progress is stored here
+		if updateErr := SafeUpdatePlatformStatus(ctx, platform); updateErr != nil {
+			klog.V(log.E).ErrorS(updateErr, "Failed to update the DB migration status", "namespace", platform.Namespace)
+		}
 		if err != nil {
 			return nil, err
 		}
-		if hasFailed(dbMigratorJobStatus) {
-			return nil, errors.New("DB migration job " + dbMigratorJobStatus.Name + " failed in namespace: " + platform.Namespace)
-		} else if hasSucceeded(dbMigratorJobStatus) {
-			return platform, nil
-		} else {
-			// DB migration is still running
-			return nil, nil
-		}
+		// DB migration is still running
+		return nil, nil
 	}
 
 	return platform, nil
@@ -291,13 +320,18 @@ func createJobDBMigration(platform *operatorapi.SonataFlowPlatform, dbmj *DBMigr
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
 						{
-							Name:  dbMigrationJobCfg.ContainerName,
-							Image: dbMigrationJobCfg.ToolImageName,
+							Name:                     dbMigrationJobCfg.ContainerName,
+							Image:                    dbMigrationJobCfg.ToolImageName,
+							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 							Env: []corev1.EnvVar{
 								{
 									Name:  migrateDBDataIndex,
 									Value: strconv.FormatBool(dbmj.MigrateDBDataIndex),
 								},
+								{
+									Name:  dryRunDBDataIndex,
+									Value: strconv.FormatBool(dbmj.DryRunDataIndex),
+								},
 								{
 									Name:  quarkusDataSourceDataIndexJdbcURL,
 									Value: diQuarkusDataSource.JdbcUrl,
@@ -328,6 +362,10 @@ func createJobDBMigration(platform *operatorapi.SonataFlowPlatform, dbmj *DBMigr
 									Name:  migrateDBJobsService,
 									Value: strconv.FormatBool(dbmj.MigrateDBJobsService),
 								},
+								{
+									Name:  dryRunDBJobsService,
+									Value: strconv.FormatBool(dbmj.DryRunJobsService),
+								},
 								{
 									Name:  quarkusDataSourceJobsServiceJdbcURL,
 									Value: jsQuarkusDataSource.JdbcUrl,
@@ -360,7 +398,7 @@ func createJobDBMigration(platform *operatorapi.SonataFlowPlatform, dbmj *DBMigr
 					RestartPolicy: "Never",
 				},
 			},
-			BackoffLimit: pointer.Int32(0),
+			BackoffLimit: pointer.Int32(dbmj.BackoffLimit),
 		},
 	}
 	return job
@@ -373,7 +411,11 @@ func (dbmj DBMigratorJob) GetDBMigrationJobStatus(ctx context.Context, client cl
 		klog.V(log.E).InfoS("Error getting DB migrator job while monitoring completion: ", "error", err, "namespace", platform.Namespace, "job", job.Name)
 		return nil, err
 	}
-	return &DBMigratorJobStatus{job.Name, &job.Status}, nil
+	var backoffLimit int32
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+	return &DBMigratorJobStatus{job.Name, &job.Status, backoffLimit}, nil
 }
 
 // NewSonataFlowPlatformDBMigrationPhase Returns a new DB migration phase for SonataFlowPlatform
@@ -416,7 +458,12 @@ func newDBMigrationJobCfg() *DBMigrationJobCfg {
 }
 
 func hasFailed(dbMigratorJobStatus *DBMigratorJobStatus) bool {
-	return dbMigratorJobStatus.BatchJobStatus.Failed == dbMigrationJobFailed
+	for _, condition := range dbMigratorJobStatus.BatchJobStatus.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return dbMigratorJobStatus.BatchJobStatus.Failed > dbMigratorJobStatus.BackoffLimit
 }
 
 func hasSucceeded(dbMigratorJobStatus *DBMigratorJobStatus) bool {
@@ -434,17 +481,97 @@ func (dbmj DBMigratorJob) ReconcileDBMigrationJob(ctx context.Context, client cl
 
 	klog.V(log.I).InfoS("Db migration job status: ", "namespace", platform.Namespace, "job", dbMigratorJobStatus.Name, "active", dbMigratorJobStatus.BatchJobStatus.Active, "ready", dbMigratorJobStatus.BatchJobStatus.Ready, "failed", dbMigratorJobStatus.BatchJobStatus.Failed, "success", dbMigratorJobStatus.BatchJobStatus.Succeeded, "CompletedIndexes", dbMigratorJobStatus.BatchJobStatus.CompletedIndexes, "terminatedPods", dbMigratorJobStatus.BatchJobStatus.UncountedTerminatedPods)
 
+	dbMigrationPhase := platform.Status.SonataFlowPlatformDBMigrationPhase
+	dbMigrationPhase.FailedAttempts = dbMigratorJobStatus.BatchJobStatus.Failed
+	var report *dbMigrationReport
+	if hasFailed(dbMigratorJobStatus) || hasSucceeded(dbMigratorJobStatus) {
+		report = getDBMigrationReport(ctx, client, platform.Namespace, dbMigratorJobStatus.Name)
+		dbmj.setDBMigrationReport(dbMigrationPhase, report)
+	}
+
 	if hasFailed(dbMigratorJobStatus) {
-		platform.Status.SonataFlowPlatformDBMigrationPhase = UpdateSonataFlowPlatformDBMigrationPhase(platform.Status.SonataFlowPlatformDBMigrationPhase, operatorapi.DBMigrationStatusFailed, operatorapi.MessageDBMigrationStatusFailed, operatorapi.ReasonDBMigrationStatusFailed)
+		UpdateSonataFlowPlatformDBMigrationPhase(dbMigrationPhase, operatorapi.DBMigrationStatusFailed, operatorapi.MessageDBMigrationStatusFailed, operatorapi.ReasonDBMigrationStatusFailed)
 		klog.V(log.I).InfoS("DB migration job failed", "namespace", platform.Namespace, "job", dbMigratorJobStatus.Name)
 		return dbMigratorJobStatus, errors.New("DB migration job failed. namespace=" + platform.Namespace + " job=" + dbMigratorJobStatus.Name)
 	} else if hasSucceeded(dbMigratorJobStatus) {
-		platform.Status.SonataFlowPlatformDBMigrationPhase = UpdateSonataFlowPlatformDBMigrationPhase(platform.Status.SonataFlowPlatformDBMigrationPhase, operatorapi.DBMigrationStatusSucceeded, operatorapi.MessageDBMigrationStatusSucceeded, operatorapi.ReasonDBMigrationStatusSucceeded)
-		klog.V(log.I).InfoS("DB migration job succeeded", "namespace", platform.Namespace, "job", dbMigratorJobStatus.Name)
+		if dbmj.isDryRun(report) {
+			UpdateSonataFlowPlatformDBMigrationPhase(dbMigrationPhase, operatorapi.DBMigrationStatusValidated, operatorapi.MessageDBMigrationStatusValidated, operatorapi.ReasonDBMigrationStatusValidated)
+		} else {
+			UpdateSonataFlowPlatformDBMigrationPhase(dbMigrationPhase, operatorapi.DBMigrationStatusSucceeded, operatorapi.MessageDBMigrationStatusSucceeded, operatorapi.ReasonDBMigrationStatusSucceeded)
+		}
+		klog.V(log.I).InfoS("DB migration job succeeded", "namespace", platform.Namespace, "job", dbMigratorJobStatus.Name, "dryRun", dbmj.isDryRun(report))
+	} else if dbMigrationPhase.FailedAttempts > 0 {
+		// the job controller retries the failed pods with an exponential backoff
+		UpdateSonataFlowPlatformDBMigrationPhase(dbMigrationPhase, operatorapi.DBMigrationStatusInProgress, operatorapi.MessageDBMigrationStatusInProgress, operatorapi.ReasonDBMigrationStatusRetrying)
 	} else {
 		// DB migration is still running
-		platform.Status.SonataFlowPlatformDBMigrationPhase = UpdateSonataFlowPlatformDBMigrationPhase(platform.Status.SonataFlowPlatformDBMigrationPhase, operatorapi.DBMigrationStatusInProgress, operatorapi.MessageDBMigrationStatusInProgress, operatorapi.ReasonDBMigrationStatusInProgress)
+		UpdateSonataFlowPlatformDBMigrationPhase(dbMigrationPhase, operatorapi.DBMigrationStatusInProgress, operatorapi.MessageDBMigrationStatusInProgress, operatorapi.ReasonDBMigrationStatusInProgress)
 	}
 
 	return dbMigratorJobStatus, nil
 }
+
+// isDryRun returns true when the DB migrator tool reports that none of the migrated services applied its migrations.
+// The report is trusted over the spec, since DB migrator tool versions without dry run support apply the migrations anyway.
+func (dbmj DBMigratorJob) isDryRun(report *dbMigrationReport) bool {
+	if report == nil {
+		return false
+	}
+	return (!dbmj.MigrateDBDataIndex || (report.DataIndex != nil && report.DataIndex.DryRun)) &&
+		(!dbmj.MigrateDBJobsService || (report.JobsService != nil && report.JobsService.DryRun))
+}
+
+// setDBMigrationReport copies the migrations reported for each migrated service into the given phase.
+func (dbmj DBMigratorJob) setDBMigrationReport(dbMigrationPhase *operatorapi.SonataFlowPlatformDBMigrationPhase, report *dbMigrationReport) {
+	if report == nil {
+		return
+	}
+	if dbmj.MigrateDBDataIndex && report.DataIndex != nil {
+		dbMigrationPhase.DataIndex = report.DataIndex
+	}
+	if dbmj.MigrateDBJobsService && report.JobsService != nil {
+		dbMigrationPhase.JobsService = report.JobsService
+	}
+}
+
+// getDBMigrationReport reads the report from the termination message of the latest terminated pod of the given job.
+// Returns nil if there's no report, for example, with DB migrator tool versions that don't write it.
+func getDBMigrationReport(ctx context.Context, client client.Client, namespace, jobName string) *dbMigrationReport {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+	if err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to list the DB migration job pods", "namespace", namespace, "job", jobName)
+		return nil
+	}
+	var latest *corev1.ContainerStateTerminated
+	for i := range pods.Items {
+		for _, status := range pods.Items[i].Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if status.Name == dbMigrationContainerName && terminated != nil && len(terminated.Message) > 0 &&
+				(latest == nil || latest.FinishedAt.Before(&terminated.FinishedAt)) {
+				latest = terminated
+			}
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	return parseDBMigrationReport(latest.Message)
+}
+
+func parseDBMigrationReport(message string) *dbMigrationReport {
+	report := &dbMigrationReport{}
+	if err := json.Unmarshal([]byte(message), report); err != nil {
+		klog.V(log.I).InfoS("Ignoring DB migration job termination message, it isn't a migration report", "error", err)
+		return nil
+	}
+	return report
+}
+
+// getDBMigrationJobHash returns the hash of the job spec settled by the operator.
+func getDBMigrationJobHash(job *batchv1.Job) (string, error) {
+	raw, err := json.Marshal(job.Spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(raw)), nil
+}
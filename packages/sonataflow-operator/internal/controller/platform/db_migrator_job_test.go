@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
@@ -72,4 +74,48 @@ func TestDbMigratorJob(t *testing.T) {
 		assert.Equal(t, dbMigrationJobCfg.JobName, DbMigrationJobName)
 		assert.Equal(t, dbMigrationJobCfg.ContainerName, DbMigrationContainerName)
 	})
+
+	t.Run("verify db migration job with dry run and backoff limit", func(t *testing.T) {
+		ksp := getBaseSonataFlowPlatformInReadyPhase(t.Name())
+		dbmj := &DBMigratorJob{MigrateDBDataIndex: true, DataIndexDataSource: &QuarkusDataSource{}, DryRunDataIndex: true, BackoffLimit: 5}
+		job := createJobDBMigration(ksp, dbmj)
+
+		assert.Equal(t, int32(5), *job.Spec.BackoffLimit)
+		assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: dryRunDBDataIndex, Value: "true"})
+		assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: dryRunDBJobsService, Value: "false"})
+		// only the report of the DB migrator tool confirms that nothing was applied
+		assert.False(t, dbmj.isDryRun(nil))
+		assert.False(t, dbmj.isDryRun(&dbMigrationReport{DataIndex: &v1alpha08.DBMigrationServiceStatus{}}))
+		assert.True(t, dbmj.isDryRun(&dbMigrationReport{DataIndex: &v1alpha08.DBMigrationServiceStatus{DryRun: true}}))
+		dbmj.MigrateDBJobsService = true
+		assert.False(t, dbmj.isDryRun(&dbMigrationReport{DataIndex: &v1alpha08.DBMigrationServiceStatus{DryRun: true}}))
+	})
+
+	t.Run("verify db migration job failure detection", func(t *testing.T) {
+		status := &DBMigratorJobStatus{Name: DbMigrationJobName, BatchJobStatus: &batchv1.JobStatus{Failed: 2}, BackoffLimit: 3}
+		assert.False(t, hasFailed(status))
+		status.BatchJobStatus.Failed = 4
+		assert.True(t, hasFailed(status))
+		status.BatchJobStatus.Failed = 1
+		status.BatchJobStatus.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		assert.True(t, hasFailed(status))
+	})
+
+	t.Run("verify db migration report in the platform status", func(t *testing.T) {
+		report := parseDBMigrationReport(`{"dataIndex":{"migrations":[{"version":"1.44.0","description":"create tables","checksum":-123,"executionTimeMillis":25,"state":"Success"}]},"jobsService":{"dryRun":true,"migrations":[{"version":"2.0.0","state":"Pending"}]}}`)
+		assert.NotNil(t, report)
+		assert.Nil(t, parseDBMigrationReport("Connection refused"))
+
+		phase := NewSonataFlowPlatformDBMigrationPhase(v1alpha08.DBMigrationStatusSucceeded, v1alpha08.MessageDBMigrationStatusSucceeded, v1alpha08.ReasonDBMigrationStatusSucceeded)
+		dbmj := DBMigratorJob{MigrateDBDataIndex: true, MigrateDBJobsService: true, DryRunJobsService: true}
+		dbmj.setDBMigrationReport(phase, report)
+
+		assert.False(t, phase.DataIndex.DryRun)
+		assert.Len(t, phase.DataIndex.Migrations, 1)
+		assert.Equal(t, "1.44.0", phase.DataIndex.Migrations[0].Version)
+		assert.Equal(t, int32(-123), *phase.DataIndex.Migrations[0].Checksum)
+		assert.Equal(t, int64(25), phase.DataIndex.Migrations[0].ExecutionTimeMillis)
+		assert.True(t, phase.JobsService.DryRun)
+		assert.Equal(t, "Pending", phase.JobsService.Migrations[0].State)
+	})
 }
@@ -45,6 +45,8 @@ const defaultSonataFlowPlatformName = "sonataflow-platform"
 
 func CreateOrUpdateWithDefaults(ctx context.Context, p *operatorapi.SonataFlowPlatform, verbose bool) error {
 	// update missing fields in the resource
+	// the Tekton build strategy works on every cluster, so it's kept
+	tekton := p.Spec.Build.Config.BuildStrategy == operatorapi.TektonBuildStrategy
 	if p.Status.Cluster == "" || utils.IsOpenShift() {
 		p.Status.Cluster = operatorapi.PlatformClusterOpenShift
 		p.Spec.Build.Config.BuildStrategy = operatorapi.PlatformBuildStrategy
@@ -53,6 +55,9 @@ func CreateOrUpdateWithDefaults(ctx context.Context, p *operatorapi.SonataFlowPl
 		p.Status.Cluster = operatorapi.PlatformClusterKubernetes
 		p.Spec.Build.Config.BuildStrategy = operatorapi.OperatorBuildStrategy
 	}
+	if tekton {
+		p.Spec.Build.Config.BuildStrategy = operatorapi.TektonBuildStrategy
+	}
 
 	err := setPlatformDefaults(p, verbose)
 	if err != nil {
@@ -124,6 +129,7 @@ func setPlatformDefaults(p *operatorapi.SonataFlowPlatform, verbose bool) error
 			p.Spec.Services.JobService.Enabled = &enable
 		}
 	}
+	setManagedPostgreSQLDefaults(p)
 	setStatusAdditionalInfo(p)
 
 	if verbose {
@@ -32,9 +32,11 @@ import (
 	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
@@ -42,6 +44,8 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/variables"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
@@ -72,6 +76,23 @@ func (action *serviceAction) Handle(ctx context.Context, platform *operatorapi.S
 		return nil, nil, err
 	}
 
+	if IsManagedPostgreSQL(platform) {
+		ready, err := createOrUpdateManagedPostgreSQL(ctx, action.client, platform)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ready {
+			klog.V(log.I).InfoS("Waiting for the managed PostgreSQL instance to be ready", "namespace", platform.Namespace, "name", GetManagedPostgreSQLName(platform))
+			return nil, nil, nil
+		}
+	}
+
+	if tls.IsCertManagerEnabled(platform) {
+		if err := createOrUpdateCertificate(ctx, action.client, platform); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	psDI := services.NewDataIndexHandler(platform)
 	psJS := services.NewJobServiceHandler(platform)
 
@@ -85,14 +106,15 @@ func (action *serviceAction) Handle(ctx context.Context, platform *operatorapi.S
 		}
 	}
 
-	if psDI.IsServiceSetInSpec() {
-		if event, err := createOrUpdateServiceComponents(ctx, action.client, platform, psDI); err != nil {
-			return nil, event, err
+	for _, psh := range []services.PlatformServiceHandler{psDI, psJS} {
+		if !psh.IsServiceSetInSpec() {
+			continue
 		}
-	}
-
-	if psJS.IsServiceSetInSpec() {
-		if event, err := createOrUpdateServiceComponents(ctx, action.client, platform, psJS); err != nil {
+		if psh.IsDBMigrationDryRun() {
+			klog.V(log.I).InfoS("Skipping the service deployment, the DB migration is in dry run mode", "namespace", platform.Namespace, "service", psh.GetServiceName())
+			continue
+		}
+		if event, err := createOrUpdateServiceComponents(ctx, action.client, platform, psh); err != nil {
 			return nil, event, err
 		}
 	}
@@ -107,9 +129,15 @@ func createOrUpdateServiceComponents(ctx context.Context, client client.Client,
 	if err := createOrUpdateDeployment(ctx, client, platform, psh); err != nil {
 		return nil, err
 	}
+	if err := createOrUpdatePodDisruptionBudget(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
 	if err := createOrUpdateService(ctx, client, platform, psh); err != nil {
 		return nil, err
 	}
+	if err := createOrUpdateNetworkPolicy(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
 	return createOrUpdateKnativeResources(ctx, client, platform, psh)
 }
 
@@ -202,6 +230,13 @@ func createOrUpdateDeployment(ctx context.Context, client client.Client, platfor
 		return err
 	}
 	kubeutil.AddOrReplaceContainer(serviceContainer.Name, *serviceContainer, &serviceDeploymentSpec.Template.Spec)
+	tls.ConfigurePodSpec(platform, serviceContainer.Name, &serviceDeploymentSpec.Template.Spec)
+	kubeutil.SetDefaultTopologySpreadConstraints(psh.GetTopologySpread(), selectorLbl, &serviceDeploymentSpec.Template.Spec)
+
+	secretChecksum, err := persistence.GetSecretChecksum(ctx, client, platform.Namespace, psh.GetPersistenceProvider())
+	if err != nil {
+		return err
+	}
 
 	serviceDeployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -220,6 +255,10 @@ func createOrUpdateDeployment(ctx context.Context, client client.Client, platfor
 		// mergo.Merge algorithm is not setting the serviceDeployment.Spec.Replicas when the
 		// *serviceDeploymentSpec.Replicas is 0. Making impossible to scale to zero. Ensure the value.
 		serviceDeployment.Spec.Replicas = serviceDeploymentSpec.Replicas
+		// same for the topology spread constraints, mergo.Merge can't remove them once the spread is disabled.
+		serviceDeployment.Spec.Template.Spec.TopologySpreadConstraints = serviceDeploymentSpec.Template.Spec.TopologySpreadConstraints
+		// a rotated persistence secret changes the checksum, rolling the service pods out
+		persistence.SetSecretChecksum(&serviceDeployment.Spec.Template.ObjectMeta, secretChecksum)
 		if err != nil {
 			return err
 		}
@@ -232,6 +271,46 @@ func createOrUpdateDeployment(ctx context.Context, client client.Client, platfor
 	return nil
 }
 
+func createOrUpdatePodDisruptionBudget(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	lbl, selectorLbl := getLabels(platform, psh)
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: platform.Namespace,
+			Name:      psh.GetServiceName(),
+			Labels:    lbl,
+		}}
+	if psh.GetDisruptionBudget() == nil {
+		return deleteControlledObject(ctx, client, platform, pdb, "Removing the PodDisruptionBudget since the disruption budget is disabled")
+	}
+	if err := controllerutil.SetControllerReference(platform, pdb, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the pod disruption budget
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, pdb, func() error {
+		pdb.Spec = kubeutil.NewPodDisruptionBudgetSpec(psh.GetDisruptionBudget(), selectorLbl)
+		return nil
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("PodDisruptionBudget successfully reconciled", "operation", op)
+	}
+	return nil
+}
+
+// deleteControlledObject deletes the given service object if it's controlled by the platform, logging the reason.
+// The objects created by the user with the same name are left untouched.
+func deleteControlledObject(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, object ctrl.Object, reason string) error {
+	if err := client.Get(ctx, ctrl.ObjectKeyFromObject(object), object); err != nil {
+		return ctrl.IgnoreNotFound(err)
+	}
+	if metav1.IsControlledBy(object, platform) {
+		klog.V(log.I).InfoS(reason, "service", object.GetName())
+		return ctrl.IgnoreNotFound(client.Delete(ctx, object))
+	}
+	return nil
+}
+
 func createOrUpdateService(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
 	lbl, selectorLbl := getLabels(platform, psh)
 	dataSvcSpec := corev1.ServiceSpec{
@@ -245,6 +324,7 @@ func createOrUpdateService(ctx context.Context, client client.Client, platform *
 		},
 		Selector: selectorLbl,
 	}
+	tls.ConfigureServiceSpec(platform, &dataSvcSpec)
 	dataSvc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: platform.Namespace,
@@ -30,6 +30,7 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
 
 	v08 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
 )
 
@@ -42,7 +43,8 @@ const (
 )
 
 func IsKanikoCacheEnabled(platform *v08.SonataFlowPlatform) bool {
-	return platform.Spec.Build.Config.IsStrategyOptionEnabled(kanikoBuildCacheEnabled)
+	return GetContainerBuilder(platform) == api.PlatformBuildPublishStrategyKaniko &&
+		platform.Spec.Build.Config.IsStrategyOptionEnabled(kanikoBuildCacheEnabled)
 }
 
 func createKanikoCacheWarmerPod(ctx context.Context, client client.Client, platform *v08.SonataFlowPlatform) error {
@@ -0,0 +1,243 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+const (
+	managedPostgreSQLContainerName      = "postgresql"
+	managedPostgreSQLUser               = "sonataflow"
+	managedPostgreSQLDatabase           = "sonataflow"
+	managedPostgreSQLUserKey            = "POSTGRES_USER"
+	managedPostgreSQLPasswordKey        = "POSTGRES_PASSWORD"
+	managedPostgreSQLDatabaseKey        = "POSTGRES_DB"
+	managedPostgreSQLDataPath           = "/var/lib/postgresql/data"
+	defaultManagedPostgreSQLStorageSize = "1Gi"
+)
+
+// IsManagedPostgreSQL returns true if the operator must deploy the PostgreSQL instance for the given platform.
+func IsManagedPostgreSQL(platform *operatorapi.SonataFlowPlatform) bool {
+	return platform.Spec.Persistence != nil && platform.Spec.Persistence.PostgreSQL != nil && platform.Spec.Persistence.PostgreSQL.Managed != nil
+}
+
+// GetManagedPostgreSQLName returns the name shared by the Secret, Service, PersistentVolumeClaim and StatefulSet of
+// the managed PostgreSQL instance.
+func GetManagedPostgreSQLName(platform *operatorapi.SonataFlowPlatform) string {
+	return fmt.Sprintf("%s-%s", platform.Name, managedPostgreSQLContainerName)
+}
+
+// setManagedPostgreSQLDefaults points the platform persistence to the managed PostgreSQL instance, so that workflows
+// and platform services connect to it as if it was declared by the user.
+func setManagedPostgreSQLDefaults(p *operatorapi.SonataFlowPlatform) {
+	if !IsManagedPostgreSQL(p) {
+		return
+	}
+	name := GetManagedPostgreSQLName(p)
+	postgreSQL := p.Spec.Persistence.PostgreSQL
+	postgreSQL.SecretRef = operatorapi.PostgreSQLSecretOptions{
+		Name:        name,
+		UserKey:     managedPostgreSQLUserKey,
+		PasswordKey: managedPostgreSQLPasswordKey,
+	}
+	postgreSQL.ServiceRef = &operatorapi.SQLServiceOptions{
+		Name:         name,
+		Namespace:    p.Namespace,
+		Port:         pointer.Int(constants.DefaultPostgreSQLPort),
+		DatabaseName: managedPostgreSQLDatabase,
+	}
+	postgreSQL.JdbcUrl = ""
+}
+
+// createOrUpdateManagedPostgreSQL ensures the resources of the managed PostgreSQL instance, all of them owned by the
+// platform so that they are garbage collected along with it. Returns true once the instance is ready to accept connections.
+func createOrUpdateManagedPostgreSQL(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform) (bool, error) {
+	name := GetManagedPostgreSQLName(platform)
+	lbl, selectorLbl := getServicesLabelsMap(platform.Name, platform.Namespace, name, managedPostgreSQLContainerName, name, platform.Name, "sonataflow-operator")
+	managed := platform.Spec.Persistence.PostgreSQL.Managed
+
+	if err := createManagedPostgreSQLSecret(ctx, client, platform, name, lbl); err != nil {
+		return false, err
+	}
+	if err := createManagedPostgreSQLPVC(ctx, client, platform, managed, name, lbl); err != nil {
+		return false, err
+	}
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: platform.Namespace, Name: name, Labels: lbl}}
+	if err := controllerutil.SetControllerReference(platform, svc, client.Scheme()); err != nil {
+		return false, err
+	}
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, svc, func() error {
+		svc.Spec.Selector = selectorLbl
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:       managedPostgreSQLContainerName,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       int32(constants.DefaultPostgreSQLPort),
+			TargetPort: intstr.FromString(managedPostgreSQLContainerName),
+		}}
+		return nil
+	}); err != nil {
+		return false, err
+	} else {
+		klog.V(log.I).InfoS("Managed PostgreSQL Service successfully reconciled", "operation", op)
+	}
+
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: platform.Namespace, Name: name, Labels: lbl}}
+	if err := controllerutil.SetControllerReference(platform, sts, client.Scheme()); err != nil {
+		return false, err
+	}
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, sts, func() error {
+		if sts.CreationTimestamp.IsZero() {
+			// immutable
+			sts.Spec.Selector = &metav1.LabelSelector{MatchLabels: selectorLbl}
+			sts.Spec.ServiceName = name
+		}
+		sts.Spec.Replicas = pointer.Int32(1)
+		sts.Spec.Template.ObjectMeta.Labels = lbl
+		sts.Spec.Template.Spec.Containers = []corev1.Container{newManagedPostgreSQLContainer(managed, name)}
+		sts.Spec.Template.Spec.Volumes = []corev1.Volume{{
+			Name: managedPostgreSQLContainerName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+			},
+		}}
+		return nil
+	}); err != nil {
+		return false, err
+	} else {
+		klog.V(log.I).InfoS("Managed PostgreSQL StatefulSet successfully reconciled", "operation", op)
+	}
+	return sts.Status.ReadyReplicas > 0, nil
+}
+
+func newManagedPostgreSQLContainer(managed *operatorapi.ManagedPostgreSQLSpec, secretName string) corev1.Container {
+	image := managed.Image
+	if len(image) == 0 {
+		image = cfg.GetCfg().ManagedPostgreSQLImageTag
+	}
+	probe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{Command: []string{"pg_isready", "-U", managedPostgreSQLUser, "-d", managedPostgreSQLDatabase}},
+		},
+		InitialDelaySeconds: int32(15),
+		TimeoutSeconds:      int32(2),
+	}
+	return corev1.Container{
+		Name:            managedPostgreSQLContainerName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Ports: []corev1.ContainerPort{{
+			Name:          managedPostgreSQLContainerName,
+			ContainerPort: int32(constants.DefaultPostgreSQLPort),
+			Protocol:      corev1.ProtocolTCP,
+		}},
+		EnvFrom: []corev1.EnvFromSource{{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: secretName}},
+		}},
+		// a subdirectory, since the volume root might contain files such as lost+found
+		Env: []corev1.EnvVar{{Name: "PGDATA", Value: managedPostgreSQLDataPath + "/pgdata"}},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      managedPostgreSQLContainerName,
+			MountPath: managedPostgreSQLDataPath,
+		}},
+		ReadinessProbe: probe,
+		LivenessProbe:  probe.DeepCopy(),
+	}
+}
+
+// createManagedPostgreSQLSecret generates the credentials of the managed instance only once, the PostgreSQL image
+// applies them solely when the data directory is initialized.
+func createManagedPostgreSQLSecret(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, name string, lbl map[string]string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: platform.Namespace, Name: name, Labels: lbl}}
+	if err := client.Get(ctx, ctrl.ObjectKeyFromObject(secret), secret); err == nil {
+		return nil
+	} else if ctrl.IgnoreNotFound(err) != nil {
+		return err
+	}
+	password, err := generatePassword()
+	if err != nil {
+		return err
+	}
+	secret.StringData = map[string]string{
+		managedPostgreSQLUserKey:     managedPostgreSQLUser,
+		managedPostgreSQLPasswordKey: password,
+		managedPostgreSQLDatabaseKey: managedPostgreSQLDatabase,
+	}
+	if err = controllerutil.SetControllerReference(platform, secret, client.Scheme()); err != nil {
+		return err
+	}
+	klog.V(log.I).InfoS("Creating the managed PostgreSQL credentials", "secret", name)
+	return client.Create(ctx, secret)
+}
+
+// createManagedPostgreSQLPVC creates the claim holding the database files. Its spec is immutable, so changes to the
+// managed storage settings only apply to new platforms.
+func createManagedPostgreSQLPVC(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, managed *operatorapi.ManagedPostgreSQLSpec, name string, lbl map[string]string) error {
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: platform.Namespace, Name: name, Labels: lbl}}
+	if err := client.Get(ctx, ctrl.ObjectKeyFromObject(pvc), pvc); err == nil {
+		return nil
+	} else if ctrl.IgnoreNotFound(err) != nil {
+		return err
+	}
+	storageSize := resource.MustParse(defaultManagedPostgreSQLStorageSize)
+	if managed.StorageSize != nil {
+		storageSize = *managed.StorageSize
+	}
+	pvc.Spec = corev1.PersistentVolumeClaimSpec{
+		AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+		StorageClassName: managed.StorageClassName,
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: storageSize},
+		},
+	}
+	if err := controllerutil.SetControllerReference(platform, pvc, client.Scheme()); err != nil {
+		return err
+	}
+	klog.V(log.I).InfoS("Creating the managed PostgreSQL storage", "persistentVolumeClaim", name)
+	return client.Create(ctx, pvc)
+}
+
+func generatePassword() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	"context"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/networkpolicy"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+// createOrUpdateNetworkPolicy ensures the NetworkPolicy allowing the workflows to reach the given service, or removes it
+// once the NetworkPolicy generation is disabled.
+func createOrUpdateNetworkPolicy(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	lbl, selectorLbl := getLabels(platform, psh)
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: platform.Namespace,
+			Name:      psh.GetServiceName(),
+			Labels:    lbl,
+		}}
+	if !networkpolicy.IsEnabled(platform) {
+		return deleteControlledObject(ctx, client, platform, policy, "Removing the NetworkPolicy since the NetworkPolicy generation is disabled")
+	}
+	if err := controllerutil.SetControllerReference(platform, policy, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the network policy
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, policy, func() error {
+		policy.Spec = networkpolicy.NewIngressSpec(metav1.LabelSelector{MatchLabels: selectorLbl},
+			networkpolicy.NewHTTPPorts(platform), newServicePeers(platform, psh)...)
+		return nil
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("NetworkPolicy successfully reconciled", "operation", op)
+	}
+	return nil
+}
+
+// newServicePeers returns the peers sending requests to the given service: the workflows, the Jobs Service reporting
+// the jobs status to the Data Index, and the broker delivering the events the service is subscribed to.
+func newServicePeers(platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) []networkingv1.NetworkPolicyPeer {
+	peers := []networkingv1.NetworkPolicyPeer{networkpolicy.NewWorkflowsPeer()}
+	jobService := services.NewJobServiceHandler(platform)
+	if psh.GetServiceName() != jobService.GetServiceName() && jobService.IsServiceEnabledInSpec() {
+		_, jobServiceSelectorLbl := getLabels(platform, jobService)
+		peers = append(peers, networkpolicy.NewPodsPeer(platform.Namespace, jobServiceSelectorLbl))
+	}
+	if psh.GetServiceSource() != nil {
+		peers = append(peers, networkpolicy.NewEventingPeer(platform))
+	}
+	return peers
+}
@@ -89,6 +89,8 @@ var _ = Describe("PlatformServiceHandler properties", func() {
 				Entry("with ephemeral persistence", generatePlatform(emptyDataIndexServiceSpec(), setPlatformName("foo"), setPlatformNamespace("default")), generateDataIndexDeploymentProperties()),
 				Entry("with postgreSQL persistence", generatePlatform(emptyDataIndexServiceSpec(), setPlatformName("foo"), setPlatformNamespace("default"), setJobServiceJDBC("jdbc:postgresql://postgres:5432/sonataflow?currentSchema=myschema")),
 					generateDataIndexDeploymentProperties()),
+				Entry("with TLS enabled", generatePlatform(emptyDataIndexServiceSpec(), setPlatformName("foo"), setPlatformNamespace("default"), setTLSSecretRef("foo-tls")),
+					generateDataIndexDeploymentWithTLSProperties()),
 			)
 		})
 
@@ -123,6 +125,19 @@ func generateDataIndexDeploymentProperties() *properties.Properties {
 	return p
 }
 
+func generateDataIndexDeploymentWithTLSProperties() *properties.Properties {
+	p := generateDataIndexDeploymentProperties()
+	p.Set("kogito.service.url", "https://foo-data-index-service.default")
+	p.Set("quarkus.http.insecure-requests", "enabled")
+	p.Set("quarkus.http.ssl-port", "8443")
+	p.Set("quarkus.tls.key-store.pem.0.cert", "/etc/sonataflow/tls/tls.crt")
+	p.Set("quarkus.tls.key-store.pem.0.key", "/etc/sonataflow/tls/tls.key")
+	p.Set("quarkus.tls.reload-period", "1h")
+	p.Set("quarkus.tls.trust-store.pem.certs", "/etc/sonataflow/tls/ca.crt")
+	p.Sort()
+	return p
+}
+
 func generateJobServiceDeploymentWithPostgreSQLProperties() *properties.Properties {
 	p := properties.NewProperties()
 	p.Set("kogito.service.url", "http://foo-jobs-service.default")
@@ -234,6 +249,12 @@ func setPlatformNamespace(namespace string) plfmOptionFn {
 	}
 }
 
+func setTLSSecretRef(secretName string) plfmOptionFn {
+	return func(p *operatorapi.SonataFlowPlatform) {
+		p.Spec.TLS = &operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: secretName}
+	}
+}
+
 func setPlatformName(name string) plfmOptionFn {
 	return func(p *operatorapi.SonataFlowPlatform) {
 		p.Name = name
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/pkg/apis"
@@ -50,12 +51,14 @@ import (
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
 )
 
 const (
 	quarkusHibernateORMDatabaseGeneration string = "QUARKUS_HIBERNATE_ORM_DATABASE_GENERATION"
 	quarkusFlywayMigrateAtStart           string = "QUARKUS_FLYWAY_MIGRATE_AT_START"
 	WaitingKnativeEventing                       = "WaitingKnativeEventing"
+	defaultDBMigrationBackoffLimit        int32  = 3
 )
 
 type PlatformServiceHandler interface {
@@ -78,6 +81,10 @@ type PlatformServiceHandler interface {
 	GetReplicaCount() int32
 	// GetDeploymentStrategy Returns the deployment strategy for the service
 	GetDeploymentStrategy() appsv1.DeploymentStrategy
+	// GetDisruptionBudget Returns the disruption budget declared in the service's pod template, nil if none
+	GetDisruptionBudget() *operatorapi.DisruptionBudgetSpec
+	// GetTopologySpread Returns the topology spread declared in the service's pod template, nil if none
+	GetTopologySpread() *operatorapi.TopologySpreadSpec
 
 	// MergeContainerSpec performs a merge with override using the containerSpec argument and the expected values based on the service's pod template specifications. The returning
 	// object is the merged result
@@ -102,6 +109,9 @@ type PlatformServiceHandler interface {
 	IsPersistenceEnabledtInSpec() bool
 	// GetLocalServiceBaseUrl returns the base url of the local service
 	GetLocalServiceBaseUrl() string
+	// GetRemoteServiceBaseUrl returns the base url of the local service for the consumers outside the platform namespace,
+	// which don't mount the platform CA.
+	GetRemoteServiceBaseUrl() string
 	// GetServiceBaseUrl returns the base url of the service, based on whether using local or cluster-scoped service.
 	GetServiceBaseUrl() string
 	// IsServiceEnabled returns true if the service is enabled in either the spec or the status.clusterPlatformRef.
@@ -122,6 +132,10 @@ type PlatformServiceHandler interface {
 
 	// Returns whether job based, service based or no DB migration is needed
 	GetDBMigrationStrategy() operatorapi.DBMigrationStrategyType
+	// IsDBMigrationDryRun returns true when the job based DB migration only validates the pending migrations
+	IsDBMigrationDryRun() bool
+	// GetPersistenceProvider returns the persistence.Provider used by the service, nil if the service is ephemeral
+	GetPersistenceProvider() persistence.Provider
 }
 
 type DataIndexHandler struct {
@@ -133,6 +147,10 @@ func (d *DataIndexHandler) GetDBMigrationStrategy() operatorapi.DBMigrationStrat
 	return GetDBMigrationStrategy(d.platform.Spec.Services.DataIndex.Persistence)
 }
 
+func (d *DataIndexHandler) IsDBMigrationDryRun() bool {
+	return d.IsServiceSetInSpec() && IsDBMigrationDryRun(d.platform.Spec.Services.DataIndex.Persistence)
+}
+
 func NewDataIndexHandler(platform *operatorapi.SonataFlowPlatform) PlatformServiceHandler {
 	return &DataIndexHandler{platform: platform}
 }
@@ -145,6 +163,9 @@ func (d DataIndexHandler) GetServiceImageName(persistenceType constants.Persiste
 	if persistenceType == constants.PersistenceTypePostgreSQL && len(cfg.GetCfg().DataIndexPostgreSQLImageTag) > 0 {
 		return cfg.GetCfg().DataIndexPostgreSQLImageTag
 	}
+	if persistenceType == constants.PersistenceTypeMySQL && len(cfg.GetCfg().DataIndexMySQLImageTag) > 0 {
+		return cfg.GetCfg().DataIndexMySQLImageTag
+	}
 	if persistenceType == constants.PersistenceTypeEphemeral && len(cfg.GetCfg().DataIndexEphemeralImageTag) > 0 {
 		return cfg.GetCfg().DataIndexEphemeralImageTag
 	}
@@ -164,7 +185,7 @@ func (d DataIndexHandler) SetServiceUrlInPlatformStatus(clusterRefPlatform *oper
 				d.platform.Status.ClusterPlatformRef.Services = &operatorapi.PlatformServicesStatus{}
 			}
 			d.platform.Status.ClusterPlatformRef.Services.DataIndexRef = &operatorapi.PlatformServiceRefStatus{
-				Url: psDI.GetLocalServiceBaseUrl(),
+				Url: psDI.GetRemoteServiceBaseUrl(),
 			}
 		}
 	}
@@ -214,6 +235,10 @@ func (d *DataIndexHandler) GetServiceBaseUrl() string {
 }
 
 func (d *DataIndexHandler) GetLocalServiceBaseUrl() string {
+	return GenerateServiceURL(tls.GetProtocol(d.platform), d.platform.Namespace, d.GetServiceName())
+}
+
+func (d *DataIndexHandler) GetRemoteServiceBaseUrl() string {
 	return GenerateServiceURL(constants.DefaultHTTPProtocol, d.platform.Namespace, d.GetServiceName())
 }
 
@@ -245,11 +270,13 @@ func (d *DataIndexHandler) MergePodSpec(podSpec corev1.PodSpec) (corev1.PodSpec,
 	return *c, err
 }
 
-// hasPostgreSQLConfigured returns true when either the SonataFlow Platform PostgreSQL CR's structure or the one in the Data Index service specification is not nil
-func (d *DataIndexHandler) hasPostgreSQLConfigured() bool {
-	return d.IsServiceSetInSpec() &&
-		((d.platform.Spec.Services.DataIndex.Persistence != nil && d.platform.Spec.Services.DataIndex.Persistence.PostgreSQL != nil) ||
-			(d.platform.Spec.Persistence != nil && d.platform.Spec.Persistence.PostgreSQL != nil))
+// GetPersistenceProvider returns the persistence.Provider configured either in the Data Index service specification or
+// in the SonataFlow Platform, nil if none.
+func (d *DataIndexHandler) GetPersistenceProvider() persistence.Provider {
+	if !d.IsServiceSetInSpec() {
+		return nil
+	}
+	return persistence.ResolveServiceProvider(d.platform.Spec.Services.DataIndex.Persistence, d.platform.Spec.Persistence, d.GetServiceName())
 }
 
 func GetDBMigrationStrategy(persistence *operatorapi.PersistenceOptionsSpec) operatorapi.DBMigrationStrategyType {
@@ -272,6 +299,19 @@ func IsJobsBasedDBMigration(persistence *operatorapi.PersistenceOptionsSpec) boo
 	return dbMigrationStrategy == operatorapi.DBMigrationStrategyJob
 }
 
+// IsDBMigrationDryRun returns true when the job based migration must only validate the pending migrations.
+func IsDBMigrationDryRun(persistence *operatorapi.PersistenceOptionsSpec) bool {
+	return IsJobsBasedDBMigration(persistence) && persistence.DBMigration != nil && persistence.DBMigration.DryRun
+}
+
+// GetDBMigrationBackoffLimit returns the number of retries of the job based migration.
+func GetDBMigrationBackoffLimit(persistence *operatorapi.PersistenceOptionsSpec) int32 {
+	if persistence != nil && persistence.DBMigration != nil && persistence.DBMigration.BackoffLimit != nil {
+		return *persistence.DBMigration.BackoffLimit
+	}
+	return defaultDBMigrationBackoffLimit
+}
+
 func IsNoDBMigration(persistence *operatorapi.PersistenceOptionsSpec) bool {
 	dbMigrationStrategy := GetDBMigrationStrategy(persistence)
 	return dbMigrationStrategy == operatorapi.DBMigrationStrategyNone || dbMigrationStrategy == ""
@@ -287,11 +327,10 @@ func isDBMigrationStrategyService(persistence *v1alpha08.PersistenceOptionsSpec)
 }
 
 func (d *DataIndexHandler) ConfigurePersistence(containerSpec *corev1.Container) *corev1.Container {
-	if d.hasPostgreSQLConfigured() {
-		p := persistence.RetrievePostgreSQLConfiguration(d.platform.Spec.Services.DataIndex.Persistence, d.platform.Spec.Persistence, d.GetServiceName())
+	if p := d.GetPersistenceProvider(); p != nil {
 		c := containerSpec.DeepCopy()
-		c.Image = d.GetServiceImageName(constants.PersistenceTypePostgreSQL)
-		c.Env = append(c.Env, persistence.ConfigurePostgreSQLEnv(p.PostgreSQL, d.GetServiceName(), d.platform.Namespace, false)...)
+		c.Image = d.GetServiceImageName(p.GetType())
+		c.Env = append(c.Env, persistence.ConfigureEnv(p, d.GetServiceName(), d.platform.Namespace, false)...)
 
 		dbMigrationStrategyService := isDBMigrationStrategyService(d.platform.Spec.Services.DataIndex.Persistence)
 
@@ -317,6 +356,14 @@ func (d *DataIndexHandler) GetDeploymentStrategy() appsv1.DeploymentStrategy {
 	return appsv1.DeploymentStrategy{}
 }
 
+func (d *DataIndexHandler) GetDisruptionBudget() *operatorapi.DisruptionBudgetSpec {
+	return d.platform.Spec.Services.DataIndex.PodTemplate.DisruptionBudget
+}
+
+func (d *DataIndexHandler) GetTopologySpread() *operatorapi.TopologySpreadSpec {
+	return d.platform.Spec.Services.DataIndex.PodTemplate.TopologySpread
+}
+
 func (d *DataIndexHandler) GetServiceCmName() string {
 	return fmt.Sprintf("%s-props", d.GetServiceName())
 }
@@ -332,6 +379,7 @@ func (d *DataIndexHandler) GenerateServiceProperties() (*properties.Properties,
 	props := properties.NewProperties()
 	props.Set(constants.KogitoServiceURLProperty, d.GetLocalServiceBaseUrl())
 	props.Set(constants.DataIndexKafkaHealthCheck, "false")
+	props.Merge(tls.GenerateProperties(d.platform))
 	return props, nil
 }
 
@@ -348,6 +396,10 @@ func (j *JobServiceHandler) GetDBMigrationStrategy() operatorapi.DBMigrationStra
 	return GetDBMigrationStrategy(j.platform.Spec.Services.JobService.Persistence)
 }
 
+func (j *JobServiceHandler) IsDBMigrationDryRun() bool {
+	return j.IsServiceSetInSpec() && IsDBMigrationDryRun(j.platform.Spec.Services.JobService.Persistence)
+}
+
 func NewJobServiceHandler(platform *operatorapi.SonataFlowPlatform) PlatformServiceHandler {
 	return &JobServiceHandler{platform: platform}
 }
@@ -360,6 +412,9 @@ func (j JobServiceHandler) GetServiceImageName(persistenceType constants.Persist
 	if persistenceType == constants.PersistenceTypePostgreSQL && len(cfg.GetCfg().JobsServicePostgreSQLImageTag) > 0 {
 		return cfg.GetCfg().JobsServicePostgreSQLImageTag
 	}
+	if persistenceType == constants.PersistenceTypeMySQL && len(cfg.GetCfg().JobsServiceMySQLImageTag) > 0 {
+		return cfg.GetCfg().JobsServiceMySQLImageTag
+	}
 	if persistenceType == constants.PersistenceTypeEphemeral && len(cfg.GetCfg().JobsServiceEphemeralImageTag) > 0 {
 		return cfg.GetCfg().JobsServiceEphemeralImageTag
 	}
@@ -383,7 +438,7 @@ func (j JobServiceHandler) SetServiceUrlInPlatformStatus(clusterRefPlatform *ope
 				j.platform.Status.ClusterPlatformRef.Services = &operatorapi.PlatformServicesStatus{}
 			}
 			j.platform.Status.ClusterPlatformRef.Services.JobServiceRef = &operatorapi.PlatformServiceRefStatus{
-				Url: psJS.GetLocalServiceBaseUrl(),
+				Url: psJS.GetRemoteServiceBaseUrl(),
 			}
 		}
 	}
@@ -433,6 +488,10 @@ func (j *JobServiceHandler) GetServiceBaseUrl() string {
 }
 
 func (j *JobServiceHandler) GetLocalServiceBaseUrl() string {
+	return GenerateServiceURL(tls.GetProtocol(j.platform), j.platform.Namespace, j.GetServiceName())
+}
+
+func (j *JobServiceHandler) GetRemoteServiceBaseUrl() string {
 	return GenerateServiceURL(constants.DefaultHTTPProtocol, j.platform.Namespace, j.GetServiceName())
 }
 
@@ -467,24 +526,33 @@ func (j *JobServiceHandler) GetDeploymentStrategy() appsv1.DeploymentStrategy {
 	}
 }
 
+func (j *JobServiceHandler) GetDisruptionBudget() *operatorapi.DisruptionBudgetSpec {
+	return j.platform.Spec.Services.JobService.PodTemplate.DisruptionBudget
+}
+
+func (j *JobServiceHandler) GetTopologySpread() *operatorapi.TopologySpreadSpec {
+	return j.platform.Spec.Services.JobService.PodTemplate.TopologySpread
+}
+
 func (j JobServiceHandler) MergeContainerSpec(containerSpec *corev1.Container) (*corev1.Container, error) {
 	return mergeContainerSpec(containerSpec, &j.platform.Spec.Services.JobService.PodTemplate.Container)
 }
 
-// hasPostgreSQLConfigured returns true when either the SonataFlow Platform PostgreSQL CR's structure or the one in the Job service specification is not nil
-func (j *JobServiceHandler) hasPostgreSQLConfigured() bool {
-	return j.IsServiceSetInSpec() &&
-		((j.platform.Spec.Services.JobService.Persistence != nil && j.platform.Spec.Services.JobService.Persistence.PostgreSQL != nil) ||
-			(j.platform.Spec.Persistence != nil && j.platform.Spec.Persistence.PostgreSQL != nil))
+// GetPersistenceProvider returns the persistence.Provider configured either in the Job service specification or
+// in the SonataFlow Platform, nil if none.
+func (j *JobServiceHandler) GetPersistenceProvider() persistence.Provider {
+	if !j.IsServiceSetInSpec() {
+		return nil
+	}
+	return persistence.ResolveServiceProvider(j.platform.Spec.Services.JobService.Persistence, j.platform.Spec.Persistence, j.GetServiceName())
 }
 
 func (j *JobServiceHandler) ConfigurePersistence(containerSpec *corev1.Container) *corev1.Container {
 
-	if j.hasPostgreSQLConfigured() {
+	if p := j.GetPersistenceProvider(); p != nil {
 		c := containerSpec.DeepCopy()
-		c.Image = j.GetServiceImageName(constants.PersistenceTypePostgreSQL)
-		p := persistence.RetrievePostgreSQLConfiguration(j.platform.Spec.Services.JobService.Persistence, j.platform.Spec.Persistence, j.GetServiceName())
-		c.Env = append(c.Env, persistence.ConfigurePostgreSQLEnv(p.PostgreSQL, j.GetServiceName(), j.platform.Namespace, true)...)
+		c.Image = j.GetServiceImageName(p.GetType())
+		c.Env = append(c.Env, persistence.ConfigureEnv(p, j.GetServiceName(), j.platform.Namespace, true)...)
 
 		dbMigrationStrategyService := isDBMigrationStrategyService(j.platform.Spec.Services.JobService.Persistence)
 
@@ -504,7 +572,7 @@ func (j *JobServiceHandler) MergePodSpec(podSpec corev1.PodSpec) (corev1.PodSpec
 
 func (j *JobServiceHandler) GenerateServiceProperties() (*properties.Properties, error) {
 	props := properties.NewProperties()
-	props.Set(constants.KogitoServiceURLProperty, GenerateServiceURL(constants.KogitoServiceURLProtocol, j.platform.Namespace, j.GetServiceName()))
+	props.Set(constants.KogitoServiceURLProperty, j.GetLocalServiceBaseUrl())
 	props.Set(constants.JobServiceKafkaSmallRyeHealthProperty, "false")
 	props.Set(constants.JobServiceLeaderLivenessSmallRyeHealthProperty, "true")
 	props.Set(constants.JobServiceLeaderCheckExpirationInSeconds, constants.DefaultJobServiceLeaderCheckExpirationInSeconds)
@@ -526,6 +594,7 @@ func (j *JobServiceHandler) GenerateServiceProperties() (*properties.Properties,
 			props.Set(constants.JobServiceStatusChangeEventsMethod, constants.Post)
 		}
 	}
+	props.Merge(tls.GenerateProperties(j.platform))
 	props.Sort()
 	return props, nil
 }
@@ -618,6 +687,14 @@ func GetPlatformBroker(platform *operatorapi.SonataFlowPlatform) *duckv1.Destina
 	return nil
 }
 
+// GetPlatformDelivery gets the default delivery of the Triggers created for the platform.
+func GetPlatformDelivery(platform *operatorapi.SonataFlowPlatform) *eventingduckv1.DeliverySpec {
+	if platform != nil && platform.Spec.Eventing != nil {
+		return knative.GetDeliverySpec(platform.Spec.Eventing.Delivery, platform.Namespace)
+	}
+	return nil
+}
+
 func (d *DataIndexHandler) GetSourceBroker() *duckv1.Destination {
 	if d.platform != nil && d.platform.Spec.Services.DataIndex.Source != nil && d.platform.Spec.Services.DataIndex.Source.Ref != nil {
 		return d.platform.Spec.Services.DataIndex.Source
@@ -651,6 +728,7 @@ func (d *DataIndexHandler) newTrigger(labels map[string]string, annotations map[
 					Path: path,
 				},
 			},
+			Delivery: GetPlatformDelivery(platform),
 		},
 	}
 }
@@ -756,6 +834,7 @@ func (j *JobServiceHandler) GenerateKnativeResources(platform *operatorapi.Sonat
 						Path: constants.JobServiceJobEventsPath,
 					},
 				},
+				Delivery: GetPlatformDelivery(platform),
 			},
 		}
 		resultObjs = append(resultObjs, jobCreateTrigger)
@@ -784,6 +863,7 @@ func (j *JobServiceHandler) GenerateKnativeResources(platform *operatorapi.Sonat
 						Path: constants.JobServiceJobEventsPath,
 					},
 				},
+				Delivery: GetPlatformDelivery(platform),
 			},
 		}
 		resultObjs = append(resultObjs, jobDeleteTrigger)
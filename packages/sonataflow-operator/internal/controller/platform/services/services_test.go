@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 )
@@ -55,3 +56,25 @@ func TestMergeContainerPreservingEnvVars(t *testing.T) {
 	assert.Equal(t, container1.Env[1], corev1.EnvVar{Name: "var2", Value: "value2"})
 	assert.Equal(t, container1.Env[2], corev1.EnvVar{Name: "var3", Value: "value3"})
 }
+
+func TestServiceBaseUrlWithTLS(t *testing.T) {
+	enabled := true
+	platform := &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: operatorapi.SonataFlowPlatformSpec{
+			TLS:      &operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "foo-tls"},
+			Services: &operatorapi.ServicesPlatformSpec{DataIndex: &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{Enabled: &enabled}}},
+		},
+	}
+	di := NewDataIndexHandler(platform)
+	assert.Equal(t, "https://foo-data-index-service.default", di.GetLocalServiceBaseUrl())
+	assert.Equal(t, "http://foo-data-index-service.default", di.GetRemoteServiceBaseUrl())
+
+	// the workflows of the referencing platform namespace don't mount the cluster platform CA
+	referencing := &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "other"},
+		Status:     operatorapi.SonataFlowPlatformStatus{ClusterPlatformRef: &operatorapi.SonataFlowClusterPlatformRefStatus{}},
+	}
+	NewDataIndexHandler(referencing).SetServiceUrlInPlatformStatus(platform)
+	assert.Equal(t, "http://foo-data-index-service.default", referencing.Status.ClusterPlatformRef.Services.DataIndexRef.Url)
+}
@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/client"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+const (
+	certManagerGroup      = "cert-manager.io"
+	certificateKind       = "Certificate"
+	defaultIssuerKind     = "Issuer"
+	certificateNameSuffix = "tls"
+)
+
+// CertificateGroupVersionKind is the cert-manager Certificate handled as unstructured content, so the operator doesn't
+// depend on the cert-manager types.
+var CertificateGroupVersionKind = schema.GroupVersionKind{Group: certManagerGroup, Version: "v1", Kind: certificateKind}
+
+// GetCertificateName returns the name of the cert-manager Certificate requested for the given platform.
+func GetCertificateName(platform *operatorapi.SonataFlowPlatform) string {
+	return fmt.Sprintf("%s-%s", platform.Name, certificateNameSuffix)
+}
+
+// createOrUpdateCertificate requests the platform certificate to cert-manager. The certificate covers every service of
+// the platform namespace, so it's shared by the workflows and the supporting services, and it's also valid as client
+// certificate for the mutual TLS authentication.
+func createOrUpdateCertificate(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform) error {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGroupVersionKind)
+	certificate.SetNamespace(platform.Namespace)
+	certificate.SetName(GetCertificateName(platform))
+	if err := controllerutil.SetControllerReference(platform, certificate, client.Scheme()); err != nil {
+		return err
+	}
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, certificate, func() error {
+		return unstructured.SetNestedField(certificate.Object, newCertificateSpec(platform), "spec")
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("Certificate successfully reconciled", "operation", op, "namespace", platform.Namespace, "name", certificate.GetName())
+	}
+	return nil
+}
+
+func newCertificateSpec(platform *operatorapi.SonataFlowPlatform) map[string]interface{} {
+	issuerRef := platform.Spec.TLS.CertManager.IssuerRef
+	issuerKind := issuerRef.Kind
+	if len(issuerKind) == 0 {
+		issuerKind = defaultIssuerKind
+	}
+	namespace := platform.Namespace
+	return map[string]interface{}{
+		"secretName": tls.GetSecretName(platform),
+		"commonName": fmt.Sprintf("%s.%s.svc", platform.Name, namespace),
+		"dnsNames": []interface{}{
+			"*." + namespace,
+			"*." + namespace + ".svc",
+			"*." + namespace + ".svc.cluster.local",
+		},
+		"usages": []interface{}{"digital signature", "key encipherment", "server auth", "client auth"},
+		"issuerRef": map[string]interface{}{
+			"group": certManagerGroup,
+			"kind":  issuerKind,
+			"name":  issuerRef.Name,
+		},
+	}
+}
@@ -81,12 +81,14 @@ const (
 	DefaultPostgresServiceName string = "postgresql"
 	DefaultDatabaseName        string = "sonataflow"
 	DefaultPostgreSQLPort      int    = 5432
+	DefaultMySQLPort           int    = 3306
 )
 
 type PersistenceType string
 
 const (
 	PersistenceTypePostgreSQL PersistenceType = "postgresql"
+	PersistenceTypeMySQL      PersistenceType = "mysql"
 	PersistenceTypeEphemeral  PersistenceType = "ephemeral"
 )
 
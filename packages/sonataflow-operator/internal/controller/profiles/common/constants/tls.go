@@ -0,0 +1,36 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package constants
+
+const (
+	HTTPSProtocol = "https"
+
+	QuarkusHTTPSSLPort           = "quarkus.http.ssl-port"
+	QuarkusHTTPSSLClientAuth     = "quarkus.http.ssl.client-auth"
+	QuarkusHTTPInsecureRequests  = "quarkus.http.insecure-requests"
+	QuarkusManagementEnabled     = "quarkus.management.enabled"
+	QuarkusManagementPort        = "quarkus.management.port"
+	QuarkusTLSKeyStorePEMCert    = "quarkus.tls.key-store.pem.0.cert"
+	QuarkusTLSKeyStorePEMKey     = "quarkus.tls.key-store.pem.0.key"
+	QuarkusTLSTrustStorePEMCerts = "quarkus.tls.trust-store.pem.certs"
+	// QuarkusTLSReloadPeriod makes Quarkus reload the certificate files, so the renewed certificates are used without a rollout.
+	QuarkusTLSReloadPeriod        = "quarkus.tls.reload-period"
+	DefaultQuarkusTLSReloadPeriod = "1h"
+)
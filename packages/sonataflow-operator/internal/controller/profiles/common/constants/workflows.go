@@ -31,4 +31,11 @@ const (
 	KogitoEventsGrouping          = "kogito.events.grouping"
 	KogitoEventsGroupingBinary    = "kogito.events.grouping.binary"
 	KogitoEventsGroupingCompress  = "kogito.events.grouping.compress"
+	KafkaBootstrapServers         = "kafka.bootstrap.servers"
+	KafkaConnector                = "smallrye-kafka"
+	// the Kafka channels of the workflow events are named after the event types
+	KafkaIncomingChannelProperty = "mp.messaging.incoming.%s.%s"
+	KafkaOutgoingChannelProperty = "mp.messaging.outgoing.%s.%s"
+	KafkaByteArrayDeserializer   = "org.apache.kafka.common.serialization.ByteArrayDeserializer"
+	KafkaStringSerializer        = "org.apache.kafka.common.serialization.StringSerializer"
 )
@@ -72,7 +72,12 @@ func (d *deploymentHandler) getDeployment(ctx context.Context, workflow *operato
 			}
 			return nil, err
 		}
-		deploymentName = ksvc.Status.LatestCreatedRevisionName + knativeDeploymentSuffix
+		revisionName := ksvc.Status.LatestCreatedRevisionName
+		if rollout := workflow.Status.Rollout; rollout != nil && rollout.Phase != operatorapi.RolloutPhaseSucceeded && len(rollout.StableRevision) > 0 {
+			// the stable revision keeps serving the traffic while a new revision is rolled out or after a rollback
+			revisionName = rollout.StableRevision
+		}
+		deploymentName = revisionName + knativeDeploymentSuffix
 	}
 	deployment := &appsv1.Deployment{}
 	if err := d.c.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: deploymentName}, deployment); err != nil {
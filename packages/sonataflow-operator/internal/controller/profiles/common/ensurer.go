@@ -242,10 +242,13 @@ func ensureObject(ctx context.Context, workflow *operatorapi.SonataFlow, visitor
 }
 
 func addToSonataFlowTriggerList(workflow *operatorapi.SonataFlow, trigger *eventingv1.Trigger) {
-	for _, t := range workflow.Status.Triggers {
+	deadLetterSinkUri := trigger.Status.DeliveryStatus.DeadLetterSinkURI
+	for i, t := range workflow.Status.Triggers {
 		if t.Name == trigger.Name && t.Namespace == trigger.Namespace {
-			return // trigger already exists
+			// trigger already exists, refresh its delivery
+			workflow.Status.Triggers[i].DeadLetterSinkUri = deadLetterSinkUri
+			return
 		}
 	}
-	workflow.Status.Triggers = append(workflow.Status.Triggers, operatorapi.SonataFlowTriggerRef{Name: trigger.Name, Namespace: trigger.Namespace})
+	workflow.Status.Triggers = append(workflow.Status.Triggers, operatorapi.SonataFlowTriggerRef{Name: trigger.Name, Namespace: trigger.Namespace, DeadLetterSinkUri: deadLetterSinkUri})
 }
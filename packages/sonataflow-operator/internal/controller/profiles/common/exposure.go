@@ -0,0 +1,307 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
+	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	gatewayAPIGroup     = "gateway.networking.k8s.io"
+	defaultExposurePath = "/"
+	httpRouteKind       = "HTTPRoute"
+	httpRouteAPIVersion = "v1"
+	gatewayKind         = "Gateway"
+	pathPrefixMatchType = "PathPrefix"
+)
+
+// HTTPRouteGroupVersionKind is the Gateway API HTTPRoute handled as unstructured content, so the operator doesn't
+// depend on the Gateway API types.
+var HTTPRouteGroupVersionKind = schema.GroupVersionKind{Group: gatewayAPIGroup, Version: httpRouteAPIVersion, Kind: httpRouteKind}
+
+// GetGatewayAPIAvailability returns true if the Gateway API is installed in the cluster.
+func GetGatewayAPIAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == gatewayAPIGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NewHTTPRoute returns an empty HTTPRoute with the given coordinates.
+func NewHTTPRoute(namespace, name string) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(HTTPRouteGroupVersionKind)
+	route.SetNamespace(namespace)
+	route.SetName(name)
+	return route
+}
+
+// ResolveExposure returns the exposure of the given workflow, merging its own settings over the platform defaults.
+// Returns nil if the workflow isn't exposed, opts out of the platform exposure, or it's deployed with Knative, which
+// exposes it on its own.
+func ResolveExposure(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) *operatorapi.ExposureSpec {
+	if workflow.IsKnativeDeployment() || (workflow.Spec.Exposure != nil && workflow.Spec.Exposure.Disabled) {
+		return nil
+	}
+	exposure := &operatorapi.ExposureSpec{}
+	if platform != nil && platform.Spec.Exposure != nil {
+		platform.Spec.Exposure.ExposureSpec.DeepCopyInto(exposure)
+		if len(exposure.Host) == 0 && len(platform.Spec.Exposure.Domain) > 0 {
+			exposure.Host = workflow.Name + "." + platform.Spec.Exposure.Domain
+		}
+	} else if workflow.Spec.Exposure == nil {
+		return nil
+	}
+	if own := workflow.Spec.Exposure; own != nil {
+		if len(own.Type) > 0 {
+			exposure.Type = own.Type
+		}
+		if len(own.Host) > 0 {
+			exposure.Host = own.Host
+		}
+		if len(own.Path) > 0 {
+			exposure.Path = own.Path
+		}
+		if len(own.TLSSecretName) > 0 {
+			exposure.TLSSecretName = own.TLSSecretName
+		}
+		if own.IngressClassName != nil {
+			exposure.IngressClassName = own.IngressClassName
+		}
+		if own.GatewayRef != nil {
+			exposure.GatewayRef = own.GatewayRef.DeepCopy()
+		}
+		if len(own.Annotations) > 0 {
+			exposure.Annotations = own.Annotations
+		}
+	}
+	if len(exposure.Type) == 0 {
+		exposure.Type = operatorapi.IngressExposureType
+	}
+	if len(exposure.Path) == 0 {
+		exposure.Path = defaultExposurePath
+	}
+	return exposure
+}
+
+// ValidateExposure verifies the exposure resolved for a workflow, the settings missing in the workflow can only be
+// checked once merged with the platform ones.
+func ValidateExposure(exposure *operatorapi.ExposureSpec) error {
+	if exposure.Type == operatorapi.HTTPRouteExposureType && exposure.GatewayRef == nil {
+		return fmt.Errorf("the %s exposure requires a gatewayRef, set it in the workflow or in the platform exposure", exposure.Type)
+	}
+	return nil
+}
+
+// IngressCreator is an ObjectCreatorWithPlatform for the Ingress exposing the workflow Service.
+// Returns nil if the workflow isn't exposed with an Ingress.
+func IngressCreator(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	exposure := ResolveExposure(workflow, platform)
+	if exposure == nil || exposure.Type != operatorapi.IngressExposureType {
+		return nil, nil
+	}
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        workflow.Name,
+			Namespace:   workflow.Namespace,
+			Labels:      workflowproj.GetMergedLabels(workflow),
+			Annotations: exposure.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: exposure.IngressClassName,
+			Rules: []networkingv1.IngressRule{{
+				Host: exposure.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     exposure.Path,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: workflow.Name,
+									Port: networkingv1.ServiceBackendPort{Number: defaultHTTPServicePort},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if len(exposure.TLSSecretName) > 0 {
+		tls := networkingv1.IngressTLS{SecretName: exposure.TLSSecretName}
+		if len(exposure.Host) > 0 {
+			tls.Hosts = []string{exposure.Host}
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{tls}
+	}
+	return ingress, nil
+}
+
+// HTTPRouteCreator is an ObjectCreatorWithPlatform for the Gateway API HTTPRoute routing to the workflow Service.
+// Returns nil if the workflow isn't exposed with an HTTPRoute.
+func HTTPRouteCreator(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	exposure := ResolveExposure(workflow, platform)
+	if exposure == nil || exposure.Type != operatorapi.HTTPRouteExposureType {
+		return nil, nil
+	}
+	route := NewHTTPRoute(workflow.Namespace, workflow.Name)
+	route.SetLabels(workflowproj.GetMergedLabels(workflow))
+	route.SetAnnotations(exposure.Annotations)
+	if err := unstructured.SetNestedField(route.Object, httpRouteSpec(workflow, exposure), "spec"); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+func httpRouteSpec(workflow *operatorapi.SonataFlow, exposure *operatorapi.ExposureSpec) map[string]interface{} {
+	spec := map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"matches": []interface{}{
+					map[string]interface{}{
+						"path": map[string]interface{}{
+							"type":  pathPrefixMatchType,
+							"value": exposure.Path,
+						},
+					},
+				},
+				"backendRefs": []interface{}{
+					map[string]interface{}{
+						"group": "",
+						"kind":  k8sServiceKind,
+						"name":  workflow.Name,
+						"port":  int64(defaultHTTPServicePort),
+					},
+				},
+			},
+		},
+	}
+	if exposure.GatewayRef != nil {
+		parentRef := map[string]interface{}{
+			"group": gatewayAPIGroup,
+			"kind":  gatewayKind,
+			"name":  exposure.GatewayRef.Name,
+		}
+		if len(exposure.GatewayRef.Namespace) > 0 {
+			parentRef["namespace"] = exposure.GatewayRef.Namespace
+		}
+		if len(exposure.GatewayRef.SectionName) > 0 {
+			parentRef["sectionName"] = exposure.GatewayRef.SectionName
+		}
+		spec["parentRefs"] = []interface{}{parentRef}
+	}
+	if len(exposure.Host) > 0 {
+		spec["hostnames"] = []interface{}{exposure.Host}
+	}
+	return spec
+}
+
+// IngressMutateVisitor guarantees the state of the workflow Ingress.
+func IngressMutateVisitor(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := IngressCreator(workflow, platform)
+			if err != nil || original == nil {
+				return err
+			}
+			ingress := object.(*networkingv1.Ingress)
+			ingress.Labels = original.GetLabels()
+			ingress.Annotations = original.GetAnnotations()
+			ingress.Spec = original.(*networkingv1.Ingress).Spec
+			return nil
+		}
+	}
+}
+
+// HTTPRouteMutateVisitor guarantees the state of the workflow HTTPRoute.
+func HTTPRouteMutateVisitor(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := HTTPRouteCreator(workflow, platform)
+			if err != nil || original == nil {
+				return err
+			}
+			route := object.(*unstructured.Unstructured)
+			route.SetLabels(original.GetLabels())
+			route.SetAnnotations(original.GetAnnotations())
+			route.Object["spec"] = original.(*unstructured.Unstructured).Object["spec"]
+			return nil
+		}
+	}
+}
+
+// GetExposureEndpoint returns the external URL of the workflow exposed by the given Ingress or HTTPRoute.
+// Without a host, the address assigned to the Ingress load balancer is used. Returns nil if the URL isn't known yet.
+func GetExposureEndpoint(exposure *operatorapi.ExposureSpec, object client.Object) *apis.URL {
+	host := exposure.Host
+	if ingress, ok := object.(*networkingv1.Ingress); ok && len(host) == 0 {
+		for _, lb := range ingress.Status.LoadBalancer.Ingress {
+			if len(lb.Hostname) > 0 {
+				host = lb.Hostname
+			} else {
+				host = lb.IP
+			}
+			break
+		}
+	}
+	if len(host) == 0 {
+		return nil
+	}
+	var url *apis.URL
+	if len(exposure.TLSSecretName) > 0 {
+		url = apis.HTTPS(host)
+	} else {
+		url = apis.HTTP(host)
+	}
+	url.Path = exposure.Path
+	return url
+}
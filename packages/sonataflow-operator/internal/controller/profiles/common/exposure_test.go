@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestResolveExposure(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	platform := test.GetBasePlatform()
+	assert.Nil(t, ResolveExposure(workflow, platform))
+
+	platform.Spec.Exposure = &v1alpha08.PlatformExposureSpec{
+		ExposureSpec: v1alpha08.ExposureSpec{TLSSecretName: "wildcard-tls"},
+		Domain:       "apps.example.com",
+	}
+	exposure := ResolveExposure(workflow, platform)
+	assert.Equal(t, v1alpha08.IngressExposureType, exposure.Type)
+	assert.Equal(t, workflow.Name+".apps.example.com", exposure.Host)
+	assert.Equal(t, "/", exposure.Path)
+	assert.Equal(t, "wildcard-tls", exposure.TLSSecretName)
+
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Host: "greeting.example.org", Path: "/greeting"}
+	exposure = ResolveExposure(workflow, platform)
+	assert.Equal(t, "greeting.example.org", exposure.Host)
+	assert.Equal(t, "/greeting", exposure.Path)
+	assert.Equal(t, "wildcard-tls", exposure.TLSSecretName)
+
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Disabled: true}
+	assert.Nil(t, ResolveExposure(workflow, platform))
+
+	workflow.Spec.Exposure = nil
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	assert.Nil(t, ResolveExposure(workflow, platform))
+}
+
+func TestValidateExposure(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Type: v1alpha08.HTTPRouteExposureType}
+	assert.Error(t, ValidateExposure(ResolveExposure(workflow, nil)))
+
+	platform := test.GetBasePlatform()
+	platform.Spec.Exposure = &v1alpha08.PlatformExposureSpec{
+		ExposureSpec: v1alpha08.ExposureSpec{GatewayRef: &v1alpha08.GatewayReference{Name: "shared"}},
+	}
+	assert.NoError(t, ValidateExposure(ResolveExposure(workflow, platform)))
+}
+
+func TestIngressCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{
+		Host:             "greeting.example.org",
+		TLSSecretName:    "greeting-tls",
+		IngressClassName: ptr.To("nginx"),
+	}
+	object, err := IngressCreator(workflow, nil)
+	assert.NoError(t, err)
+	ingress := object.(*networkingv1.Ingress)
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	assert.Len(t, ingress.Spec.Rules, 1)
+	assert.Equal(t, "greeting.example.org", ingress.Spec.Rules[0].Host)
+	path := ingress.Spec.Rules[0].HTTP.Paths[0]
+	assert.Equal(t, "/", path.Path)
+	assert.Equal(t, workflow.Name, path.Backend.Service.Name)
+	assert.Equal(t, int32(defaultHTTPServicePort), path.Backend.Service.Port.Number)
+	assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{"greeting.example.org"}, SecretName: "greeting-tls"}}, ingress.Spec.TLS)
+	assert.Equal(t, "https://greeting.example.org/", GetExposureEndpoint(ResolveExposure(workflow, nil), ingress).String())
+
+	route, err := HTTPRouteCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, route)
+}
+
+func TestHTTPRouteCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{
+		Type:       v1alpha08.HTTPRouteExposureType,
+		Host:       "greeting.example.org",
+		Path:       "/greeting",
+		GatewayRef: &v1alpha08.GatewayReference{Name: "shared", Namespace: "gateways"},
+	}
+	object, err := HTTPRouteCreator(workflow, nil)
+	assert.NoError(t, err)
+	route := object.(*unstructured.Unstructured)
+	assert.Equal(t, HTTPRouteGroupVersionKind, route.GroupVersionKind())
+
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	assert.Equal(t, []string{"greeting.example.org"}, hostnames)
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	assert.Len(t, parentRefs, 1)
+	assert.Equal(t, "shared", parentRefs[0].(map[string]interface{})["name"])
+	assert.Equal(t, "gateways", parentRefs[0].(map[string]interface{})["namespace"])
+	assert.Equal(t, "http://greeting.example.org/greeting", GetExposureEndpoint(ResolveExposure(workflow, nil), route).String())
+
+	ingress, err := IngressCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, ingress)
+}
+
+func TestGetExposureEndpointFromIngressLoadBalancer(t *testing.T) {
+	exposure := &v1alpha08.ExposureSpec{Path: "/"}
+	ingress := &networkingv1.Ingress{}
+	assert.Nil(t, GetExposureEndpoint(exposure, ingress))
+
+	ingress.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}}
+	assert.Equal(t, "http://10.0.0.1/", GetExposureEndpoint(exposure, ingress).String())
+}
@@ -0,0 +1,234 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package kafka resolves the Kafka eventing of the workflows producing and consuming their events directly with Kafka
+// topics, without Knative Eventing.
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
+)
+
+const (
+	// StrimziGroup is the API group of the Strimzi resources.
+	StrimziGroup = "kafka.strimzi.io"
+	// StrimziClusterLabel tells the Strimzi Topic Operator the Kafka cluster a KafkaTopic belongs to.
+	StrimziClusterLabel = "strimzi.io/cluster"
+	kafkaTopicKind      = "KafkaTopic"
+	defaultPartitions   = 1
+	defaultReplicas     = 1
+)
+
+// KafkaTopicGroupVersionKind is the Strimzi KafkaTopic handled as unstructured content, so the operator doesn't
+// depend on the Strimzi types.
+var KafkaTopicGroupVersionKind = schema.GroupVersionKind{Group: StrimziGroup, Version: "v1beta2", Kind: kafkaTopicKind}
+
+// Channel is a workflow event mapped to a Kafka topic.
+type Channel struct {
+	// EventType names the channel of the event in the workflow application.
+	EventType string
+	Topic     string
+	Kind      cncfmodel.EventKind
+}
+
+// IsEnabled returns true if the given workflow produces and consumes its events with Kafka topics. That's the case
+// when the workflow defines its own Kafka eventing, or when it defines no Knative sink nor sources and the platform
+// defines a Kafka cluster.
+func IsEnabled(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return GetCluster(workflow, platform) != nil
+}
+
+// GetCluster returns the Kafka cluster of the given workflow, with the settings it doesn't define taken from the
+// platform. Returns nil if the workflow doesn't use the Kafka eventing.
+func GetCluster(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) *operatorapi.KafkaClusterSpec {
+	if workflow == nil {
+		return nil
+	}
+	var platformCluster *operatorapi.KafkaClusterSpec
+	if platform != nil && platform.Spec.Eventing != nil {
+		platformCluster = platform.Spec.Eventing.Kafka
+	}
+	if workflow.Spec.Kafka == nil {
+		if platformCluster == nil || workflow.Spec.Sink != nil || len(workflow.Spec.Sources) > 0 {
+			return nil
+		}
+		return platformCluster.DeepCopy()
+	}
+	cluster := workflow.Spec.Kafka.KafkaClusterSpec.DeepCopy()
+	if platformCluster != nil {
+		if len(cluster.BootstrapServers) == 0 {
+			cluster.BootstrapServers = platformCluster.BootstrapServers
+		}
+		if cluster.Strimzi == nil {
+			cluster.Strimzi = platformCluster.Strimzi.DeepCopy()
+		}
+	}
+	return cluster
+}
+
+// GetTopic returns the topic the events of the given type are mapped to.
+func GetTopic(workflow *operatorapi.SonataFlow, eventType string) string {
+	if workflow.Spec.Kafka != nil {
+		for _, topic := range workflow.Spec.Kafka.Topics {
+			if topic.EventType == eventType {
+				return topic.Topic
+			}
+		}
+	}
+	return eventType
+}
+
+// GetChannels returns the channels of the events the workflow produces and consumes, one per event type and kind.
+func GetChannels(workflow *operatorapi.SonataFlow) []Channel {
+	var channels []Channel
+	seen := make(map[string]bool)
+	for _, event := range workflow.Spec.Flow.Events {
+		kind := event.Kind
+		if kind != cncfmodel.EventKindProduced {
+			kind = cncfmodel.EventKindConsumed
+		}
+		key := string(kind) + "/" + event.Type
+		if len(event.Type) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		channels = append(channels, Channel{EventType: event.Type, Topic: GetTopic(workflow, event.Type), Kind: kind})
+	}
+	return channels
+}
+
+// GenerateProperties returns the smallrye-kafka properties connecting the workflow event channels to their topics.
+// The consumers of the workflow replicas share a group, so every event is only handled once.
+// Never nil.
+func GenerateProperties(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) *properties.Properties {
+	props := properties.NewProperties()
+	cluster := GetCluster(workflow, platform)
+	if cluster == nil {
+		return props
+	}
+	if len(cluster.BootstrapServers) > 0 {
+		props.Set(constants.KafkaBootstrapServers, cluster.BootstrapServers)
+	}
+	for _, channel := range GetChannels(workflow) {
+		name := channelName(channel.EventType)
+		if channel.Kind == cncfmodel.EventKindProduced {
+			props.Set(fmt.Sprintf(constants.KafkaOutgoingChannelProperty, name, "connector"), constants.KafkaConnector)
+			props.Set(fmt.Sprintf(constants.KafkaOutgoingChannelProperty, name, "topic"), channel.Topic)
+			props.Set(fmt.Sprintf(constants.KafkaOutgoingChannelProperty, name, "value.serializer"), constants.KafkaStringSerializer)
+		} else {
+			props.Set(fmt.Sprintf(constants.KafkaIncomingChannelProperty, name, "connector"), constants.KafkaConnector)
+			props.Set(fmt.Sprintf(constants.KafkaIncomingChannelProperty, name, "topic"), channel.Topic)
+			props.Set(fmt.Sprintf(constants.KafkaIncomingChannelProperty, name, "value.deserializer"), constants.KafkaByteArrayDeserializer)
+			props.Set(fmt.Sprintf(constants.KafkaIncomingChannelProperty, name, "group.id"), workflow.Name)
+		}
+	}
+	return props
+}
+
+// channelName quotes the event types containing dots, so they're read as a single segment of the property names.
+func channelName(eventType string) string {
+	if strings.Contains(eventType, ".") {
+		return strconv.Quote(eventType)
+	}
+	return eventType
+}
+
+// GetExtensions returns the Quarkus extensions required for the Kafka eventing.
+func GetExtensions() []cfg.GroupArtifactId {
+	return cfg.GetCfg().KafkaEventingExtensions
+}
+
+// GetKafkaTopicName returns the name of the KafkaTopic of the given topic, the topic itself when it's a valid
+// resource name.
+func GetKafkaTopicName(topic string) string {
+	if len(validation.IsDNS1123Subdomain(topic)) == 0 {
+		return topic
+	}
+	name := strings.Trim(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, topic), "-.")
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = strings.Trim(name[:validation.DNS1123SubdomainMaxLength], "-.")
+	}
+	return name
+}
+
+// GetStrimziAvailability returns true if Strimzi is installed in the cluster.
+func GetStrimziAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == StrimziGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NewKafkaTopic returns the Strimzi KafkaTopic of the given topic.
+func NewKafkaTopic(strimzi *operatorapi.StrimziTopicsSpec, namespace, topic string) *unstructured.Unstructured {
+	kafkaTopic := &unstructured.Unstructured{}
+	kafkaTopic.SetGroupVersionKind(KafkaTopicGroupVersionKind)
+	if len(strimzi.Namespace) > 0 {
+		namespace = strimzi.Namespace
+	}
+	kafkaTopic.SetNamespace(namespace)
+	kafkaTopic.SetName(GetKafkaTopicName(topic))
+	kafkaTopic.SetLabels(map[string]string{StrimziClusterLabel: strimzi.ClusterName})
+	partitions, replicas := int64(defaultPartitions), int64(defaultReplicas)
+	if strimzi.Partitions != nil {
+		partitions = int64(*strimzi.Partitions)
+	}
+	if strimzi.Replicas != nil {
+		replicas = int64(*strimzi.Replicas)
+	}
+	kafkaTopic.Object["spec"] = map[string]interface{}{
+		"topicName":  topic,
+		"partitions": partitions,
+		"replicas":   replicas,
+	}
+	return kafkaTopic
+}
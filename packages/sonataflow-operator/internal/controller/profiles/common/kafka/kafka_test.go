@@ -0,0 +1,108 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kafka
+
+import (
+	"testing"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+func newWorkflow() *operatorapi.SonataFlow {
+	workflow := &operatorapi.SonataFlow{ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"}}
+	workflow.Spec.Flow.Events = []cncfmodel.Event{
+		{Name: "orderReceived", Type: "org.acme.order.received", Kind: cncfmodel.EventKindConsumed},
+		{Name: "orderReceivedAgain", Type: "org.acme.order.received", Kind: cncfmodel.EventKindConsumed},
+		{Name: "orderShipped", Type: "shipped", Kind: cncfmodel.EventKindProduced},
+	}
+	return workflow
+}
+
+func newPlatform(kafka *operatorapi.KafkaClusterSpec) *operatorapi.SonataFlowPlatform {
+	return &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonataflow-platform", Namespace: "default"},
+		Spec:       operatorapi.SonataFlowPlatformSpec{Eventing: &operatorapi.PlatformEventingSpec{Kafka: kafka}},
+	}
+}
+
+func TestGetCluster(t *testing.T) {
+	workflow := newWorkflow()
+	platform := newPlatform(&operatorapi.KafkaClusterSpec{
+		BootstrapServers: "platform-kafka:9092",
+		Strimzi:          &operatorapi.StrimziTopicsSpec{ClusterName: "platform-kafka"},
+	})
+	assert.False(t, IsEnabled(workflow, nil))
+	assert.Equal(t, "platform-kafka:9092", GetCluster(workflow, platform).BootstrapServers)
+
+	workflow.Spec.Sink = &duckv1.Destination{Ref: &duckv1.KReference{Name: "default"}}
+	assert.False(t, IsEnabled(workflow, platform))
+
+	workflow.Spec.Sink = nil
+	workflow.Spec.Kafka = &operatorapi.KafkaEventingSpec{KafkaClusterSpec: operatorapi.KafkaClusterSpec{BootstrapServers: "orders-kafka:9092"}}
+	cluster := GetCluster(workflow, platform)
+	assert.Equal(t, "orders-kafka:9092", cluster.BootstrapServers)
+	assert.Equal(t, "platform-kafka", cluster.Strimzi.ClusterName)
+	assert.True(t, IsEnabled(workflow, nil))
+}
+
+func TestGenerateProperties(t *testing.T) {
+	workflow := newWorkflow()
+	workflow.Spec.Kafka = &operatorapi.KafkaEventingSpec{
+		KafkaClusterSpec: operatorapi.KafkaClusterSpec{BootstrapServers: "kafka:9092"},
+		Topics:           []operatorapi.KafkaTopicSpec{{EventType: "shipped", Topic: "shipments"}},
+	}
+	props := GenerateProperties(workflow, nil)
+	assert.Equal(t, map[string]string{
+		"kafka.bootstrap.servers":                                            "kafka:9092",
+		`mp.messaging.incoming."org.acme.order.received".connector`:          "smallrye-kafka",
+		`mp.messaging.incoming."org.acme.order.received".topic`:              "org.acme.order.received",
+		`mp.messaging.incoming."org.acme.order.received".value.deserializer`: "org.apache.kafka.common.serialization.ByteArrayDeserializer",
+		`mp.messaging.incoming."org.acme.order.received".group.id`:           "orders",
+		"mp.messaging.outgoing.shipped.connector":                            "smallrye-kafka",
+		"mp.messaging.outgoing.shipped.topic":                                "shipments",
+		"mp.messaging.outgoing.shipped.value.serializer":                     "org.apache.kafka.common.serialization.StringSerializer",
+	}, props.Map())
+
+	workflow.Spec.Kafka = nil
+	assert.Zero(t, GenerateProperties(workflow, nil).Len())
+}
+
+func TestNewKafkaTopic(t *testing.T) {
+	strimzi := &operatorapi.StrimziTopicsSpec{ClusterName: "my-cluster", Namespace: "kafka", Replicas: ptr.To(int32(3))}
+	kafkaTopic := NewKafkaTopic(strimzi, "default", "Orders_Received")
+	assert.Equal(t, "orders-received", kafkaTopic.GetName())
+	assert.Equal(t, "kafka", kafkaTopic.GetNamespace())
+	assert.Equal(t, "my-cluster", kafkaTopic.GetLabels()[StrimziClusterLabel])
+	topicName, _, _ := unstructured.NestedString(kafkaTopic.Object, "spec", "topicName")
+	assert.Equal(t, "Orders_Received", topicName)
+	partitions, _, _ := unstructured.NestedInt64(kafkaTopic.Object, "spec", "partitions")
+	assert.Equal(t, int64(1), partitions)
+	replicas, _, _ := unstructured.NestedInt64(kafkaTopic.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+
+	assert.Equal(t, "org.acme.order.received", GetKafkaTopicName("org.acme.order.received"))
+}
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+var _ KafkaEventingHandler = &kafkaObjectManager{}
+
+// KafkaEventingHandler ensures the resources required by the workflows producing and consuming their events directly
+// with Kafka topics.
+type KafkaEventingHandler interface {
+	Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error)
+}
+
+type kafkaObjectManager struct {
+	platform *operatorapi.SonataFlowPlatform
+	*StateSupport
+}
+
+func NewKafkaEventingHandler(support *StateSupport, pl *operatorapi.SonataFlowPlatform) KafkaEventingHandler {
+	return &kafkaObjectManager{
+		platform:     pl,
+		StateSupport: support,
+	}
+}
+
+// Ensure creates the Strimzi KafkaTopics of the workflow topics that don't exist yet, when requested.
+// The KafkaTopics aren't owned by the workflow, nor updated or deleted by the operator: the topics are usually shared
+// by the producer and consumer workflows, and removing a KafkaTopic removes its events.
+func (k *kafkaObjectManager) Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error) {
+	cluster := kafka.GetCluster(workflow, k.platform)
+	if cluster == nil || cluster.Strimzi == nil {
+		return nil, nil
+	}
+	strimziAvail, err := kafka.GetStrimziAvailability(k.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !strimziAvail {
+		klog.V(log.I).InfoS("Strimzi is not installed, the workflow topics won't be created", "workflow", workflow.Name)
+		if k.Recorder != nil {
+			k.Recorder.Event(workflow, corev1.EventTypeWarning, "StrimziNotAvailable",
+				"Strimzi is not available in this cluster, the Kafka topics of the workflow must be created manually")
+		}
+		return nil, nil
+	}
+
+	var objs []client.Object
+	topics := make(map[string]bool)
+	for _, channel := range kafka.GetChannels(workflow) {
+		if topics[channel.Topic] {
+			continue
+		}
+		topics[channel.Topic] = true
+		kafkaTopic := kafka.NewKafkaTopic(cluster.Strimzi, workflow.Namespace, channel.Topic)
+		if err := k.C.Create(ctx, kafkaTopic); err != nil {
+			if !errors.IsAlreadyExists(err) {
+				return objs, err
+			}
+		} else {
+			klog.V(log.I).InfoS("KafkaTopic successfully created", "namespace", kafkaTopic.GetNamespace(), "name", kafkaTopic.GetName())
+		}
+		objs = append(objs, kafkaTopic)
+	}
+	return objs, nil
+}
@@ -71,7 +71,7 @@ func (k knativeObjectManager) Ensure(ctx context.Context, workflow *operatorapi.
 			objs = append(objs, sinkBinding)
 		}
 
-		triggers := k.trigger.Ensure(ctx, workflow, k.platform)
+		triggers := k.trigger.Ensure(ctx, workflow, k.platform, TriggerDeliveryMutateVisitor(workflow, k.platform))
 		for _, trigger := range triggers {
 			if trigger.Error != nil {
 				return objs, trigger.Error
@@ -27,16 +27,22 @@ import (
 
 	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/properties"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
 	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	knativeautoscaling "knative.dev/serving/pkg/apis/autoscaling"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -120,7 +126,10 @@ func DeploymentMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.
 
 // EnsureDeployment Ensure that the original Deployment fields are immutable.
 func EnsureDeployment(original *appsv1.Deployment, object *appsv1.Deployment) error {
-	object.Spec.Replicas = original.Spec.Replicas
+	// the replicas are owned by the HorizontalPodAutoscaler when the original doesn't define them
+	if original.Spec.Replicas != nil {
+		object.Spec.Replicas = original.Spec.Replicas
+	}
 	object.Spec.Selector = original.Spec.Selector
 	object.Labels = original.GetLabels()
 	object.Finalizers = original.Finalizers
@@ -133,10 +142,74 @@ func EnsureDeployment(original *appsv1.Deployment, object *appsv1.Deployment) er
 		object.Spec.Template.Spec.Containers[i].VolumeMounts = nil
 	}
 
+	// the topology spread constraints are either declared by the user or defaulted by the operator, the merge can't remove them
+	object.Spec.Template.Spec.TopologySpreadConstraints = original.Spec.Template.Spec.TopologySpreadConstraints
+
 	// we do a merge to not keep changing the spec since k8s will set default values to the podSpec
 	return mergo.Merge(&object.Spec.Template.Spec, original.Spec.Template.Spec, mergo.WithOverride)
 }
 
+// knativeAutoscalingAnnotations the revision annotations managed by the workflow autoscaling settings.
+var knativeAutoscalingAnnotations = []string{
+	knativeautoscaling.ClassAnnotationKey,
+	knativeautoscaling.MinScaleAnnotationKey,
+	knativeautoscaling.MaxScaleAnnotationKey,
+	knativeautoscaling.MetricAnnotationKey,
+	knativeautoscaling.TargetAnnotationKey,
+}
+
+func ensureKnativeAutoscalingAnnotations(original *servingv1.Service, object *servingv1.Service) {
+	for _, key := range knativeAutoscalingAnnotations {
+		value, ok := original.Spec.Template.Annotations[key]
+		if ok {
+			if object.Spec.Template.Annotations == nil {
+				object.Spec.Template.Annotations = map[string]string{}
+			}
+			object.Spec.Template.Annotations[key] = value
+		} else {
+			delete(object.Spec.Template.Annotations, key)
+		}
+	}
+}
+
+// HorizontalPodAutoscalerMutateVisitor guarantees the state of the workflow HorizontalPodAutoscaler.
+func HorizontalPodAutoscalerMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := HorizontalPodAutoscalerCreator(workflow)
+			if err != nil || original == nil {
+				return err
+			}
+			hpa := object.(*autoscalingv2.HorizontalPodAutoscaler)
+			hpa.Labels = original.GetLabels()
+			hpa.Spec = original.(*autoscalingv2.HorizontalPodAutoscaler).Spec
+			return nil
+		}
+	}
+}
+
+// PodDisruptionBudgetMutateVisitor guarantees the state of the workflow PodDisruptionBudget.
+func PodDisruptionBudgetMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := PodDisruptionBudgetCreator(workflow)
+			if err != nil || original == nil {
+				return err
+			}
+			pdb := object.(*policyv1.PodDisruptionBudget)
+			pdb.Labels = original.GetLabels()
+			pdb.Spec = original.(*policyv1.PodDisruptionBudget).Spec
+			return nil
+		}
+	}
+}
+
 // KServiceMutateVisitor guarantees the state of the default Knative Service object
 func KServiceMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
@@ -156,6 +229,7 @@ func KServiceMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.So
 // EnsureKService Ensure that the original Knative Service fields are immutable.
 func EnsureKService(original *servingv1.Service, object *servingv1.Service) error {
 	object.Labels = original.GetLabels()
+	ensureKnativeAutoscalingAnnotations(original, object)
 
 	// Clean up the volumes, they are inherited from original, additional are added by other visitors
 	// However, the knative data (voulmes, volumes mounts) must be preserved
@@ -169,6 +243,27 @@ func EnsureKService(original *servingv1.Service, object *servingv1.Service) erro
 	return mergo.Merge(&object.Spec.Template.Spec.PodSpec, original.Spec.Template.Spec.PodSpec, mergo.WithOverride)
 }
 
+// TriggerDeliveryMutateVisitor guarantees the delivery of the workflow Triggers follows the workflow sources and the platform eventing.
+func TriggerDeliveryMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			trigger := object.(*eventingv1.Trigger)
+			if trigger.Spec.Filter == nil {
+				return nil
+			}
+			delivery, err := getDeliveryForEventType(trigger.Spec.Filter.Attributes["type"], workflow, plf)
+			if err != nil {
+				return err
+			}
+			trigger.Spec.Delivery = delivery
+			return nil
+		}
+	}
+}
+
 func ServiceMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
 		return func() error {
@@ -227,6 +322,43 @@ func RolloutDeploymentIfCMChangedMutateVisitor(workflow *operatorapi.SonataFlow,
 	}
 }
 
+// PersistenceSecretChecksumMutateVisitor annotates the pod template with the checksum of the persistence credentials,
+// forcing a rollout when the referenced Secret is rotated.
+func PersistenceSecretChecksumMutateVisitor(checksum string) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			switch o := object.(type) {
+			case *appsv1.Deployment:
+				persistence.SetSecretChecksum(&o.Spec.Template.ObjectMeta, checksum)
+			case *servingv1.Service:
+				persistence.SetSecretChecksum(&o.Spec.Template.ObjectMeta, checksum)
+			}
+			return nil
+		}
+	}
+}
+
+// TLSMutateVisitor mounts the platform certificate in the workflow container, and, when the workflow serves HTTPS
+// itself, declares the HTTPS port in the container and in the Service.
+func TLSMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if !tls.IsEnabledForWorkflow(workflow, plf) {
+				return nil
+			}
+			switch o := object.(type) {
+			case *appsv1.Deployment:
+				tls.ConfigurePodSpec(plf, operatorapi.DefaultContainerName, &o.Spec.Template.Spec)
+			case *servingv1.Service:
+				tls.MountCertificate(plf, operatorapi.DefaultContainerName, &o.Spec.Template.Spec.PodSpec)
+			case *corev1.Service:
+				tls.ConfigureServiceSpec(plf, &o.Spec)
+			}
+			return nil
+		}
+	}
+}
+
 func RestoreDeploymentVolumeAndVolumeMountMutateVisitor() MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
 		return func() error {
@@ -0,0 +1,251 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/networkpolicy"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/schedule"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+var _ NetworkPolicyHandler = &networkPolicyManager{}
+
+// NetworkPolicyHandler ensures the NetworkPolicies allowing the traffic a workflow takes part in.
+type NetworkPolicyHandler interface {
+	Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error)
+}
+
+type networkPolicyManager struct {
+	platform *operatorapi.SonataFlowPlatform
+	*StateSupport
+}
+
+func NewNetworkPolicyHandler(support *StateSupport, pl *operatorapi.SonataFlowPlatform) NetworkPolicyHandler {
+	return &networkPolicyManager{
+		platform:     pl,
+		StateSupport: support,
+	}
+}
+
+// Ensure creates or updates the NetworkPolicy allowing the Jobs Service and the brokers to reach the workflow, and the
+// ones allowing the workflow to reach the services it discovers in its namespace. The policies no longer needed by the
+// workflow are removed, so they follow the workflow and its discovery references as they change.
+func (n *networkPolicyManager) Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error) {
+	var policies []*networkingv1.NetworkPolicy
+	if networkpolicy.IsEnabledForWorkflow(workflow, n.platform) {
+		if policy, err := newWorkflowNetworkPolicy(workflow, n.platform); err != nil {
+			return nil, err
+		} else if policy != nil {
+			policies = append(policies, policy)
+		}
+		discoveryPolicies, err := n.newDiscoveryNetworkPolicies(ctx, workflow)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, discoveryPolicies...)
+	}
+	if err := n.deleteUnusedNetworkPolicies(ctx, workflow, policies); err != nil {
+		return nil, err
+	}
+
+	var objs []client.Object
+	for _, policy := range policies {
+		spec := policy.Spec
+		policyLabels := policy.Labels
+		if err := controllerutil.SetControllerReference(workflow, policy, n.C.Scheme()); err != nil {
+			return objs, err
+		}
+		if op, err := controllerutil.CreateOrUpdate(ctx, n.C, policy, func() error {
+			policy.Labels = workflowproj.GetMergedLabels(workflow)
+			for k, v := range policyLabels {
+				policy.Labels[k] = v
+			}
+			policy.Spec = spec
+			return nil
+		}); err != nil {
+			return objs, err
+		} else {
+			klog.V(log.I).InfoS("NetworkPolicy successfully reconciled", "operation", op, "name", policy.Name)
+		}
+		objs = append(objs, policy)
+	}
+	return objs, nil
+}
+
+// newWorkflowNetworkPolicy returns the NetworkPolicy allowing the Jobs Service callbacks, the events delivered by the
+// brokers and the CronJobs of the workflow schedules to reach the workflow. Returns nil if the workflow receives none of them, or it's deployed with Knative,
+// which routes the requests through its own data plane.
+func newWorkflowNetworkPolicy(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (*networkingv1.NetworkPolicy, error) {
+	if workflow.IsKnativeDeployment() {
+		return nil, nil
+	}
+	var peers []networkingv1.NetworkPolicyPeer
+	if peer := newJobServicePeer(plf); peer != nil {
+		peers = append(peers, *peer)
+	}
+	if consumes, err := consumesBrokerEvents(workflow, plf); err != nil {
+		return nil, err
+	} else if consumes {
+		peers = append(peers, networkpolicy.NewEventingPeer(plf))
+	}
+	if len(workflow.Spec.Schedules) > 0 {
+		peers = append(peers, networkpolicy.NewPodsPeer(workflow.Namespace, map[string]string{schedule.LabelScheduleWorkflow: workflow.Name}))
+	}
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace},
+		Spec: networkpolicy.NewIngressSpec(metav1.LabelSelector{MatchLabels: networkpolicy.GetWorkflowPodLabels(workflow)},
+			networkpolicy.NewHTTPPorts(plf), peers...),
+	}, nil
+}
+
+// newJobServicePeer returns the peer matching the Jobs Service used by the workflows of the given platform, either its
+// own or the one shared by the SonataFlowClusterPlatform. Returns nil if no Jobs Service is used.
+func newJobServicePeer(plf *operatorapi.SonataFlowPlatform) *networkingv1.NetworkPolicyPeer {
+	jobService := services.NewJobServiceHandler(plf)
+	if !jobService.IsServiceEnabled() {
+		return nil
+	}
+	servicesPlatform := plf
+	if !jobService.IsServiceEnabledInSpec() {
+		ref := plf.Status.ClusterPlatformRef.PlatformRef
+		servicesPlatform = &operatorapi.SonataFlowPlatform{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace}}
+	}
+	peer := networkpolicy.NewPodsPeer(servicesPlatform.Namespace,
+		map[string]string{workflowproj.LabelService: services.NewJobServiceHandler(servicesPlatform).GetServiceName()})
+	return &peer
+}
+
+// consumesBrokerEvents returns true if the workflow has Triggers subscribing it to the events of a Knative broker.
+func consumesBrokerEvents(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (bool, error) {
+	for _, event := range workflow.Spec.Flow.Events {
+		if event.Kind == cncfmodel.EventKindProduced {
+			continue
+		}
+		brokerRef, err := getBrokerRefForEventType(event.Type, workflow, plf)
+		if err != nil {
+			return false, err
+		}
+		if brokerRef != nil && knative.IsKnativeBroker(brokerRef) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// newDiscoveryNetworkPolicies returns the NetworkPolicies allowing the workflow to reach the pods serving the resources
+// its discovery references were resolved from. Only the resources of the workflow namespace are considered, the
+// traffic to other namespaces must be allowed by their owners.
+// The pods not isolated yet are skipped: selecting them in an ingress policy would cut off their other clients.
+func (n *networkPolicyManager) newDiscoveryNetworkPolicies(ctx context.Context, workflow *operatorapi.SonataFlow) ([]*networkingv1.NetworkPolicy, error) {
+	var policies []*networkingv1.NetworkPolicy
+	var namespacePolicies *networkingv1.NetworkPolicyList
+	names := make(map[string]bool)
+	for _, reference := range workflow.Status.DiscoveryReferences {
+		if len(reference.Error) > 0 {
+			continue
+		}
+		uri, err := discovery.ParseUri(reference.Uri)
+		if err != nil {
+			continue
+		}
+		if len(uri.Namespace) == 0 {
+			uri.Namespace = workflow.Namespace
+		}
+		if uri.Namespace != workflow.Namespace {
+			klog.V(log.I).InfoS("Skipping the NetworkPolicy for a discovered service outside the workflow namespace", "uri", reference.Uri)
+			continue
+		}
+		name := fmt.Sprintf("%s-%s-%s", workflow.Name, strings.TrimSuffix(uri.GVK.Kind, "s"), uri.Name)
+		if names[name] {
+			continue
+		}
+		podSelector, err := discovery.GetPodSelector(ctx, n.C, *uri)
+		if err != nil {
+			klog.V(log.E).ErrorS(err, "Failed to read the pods serving a discovered service", "uri", reference.Uri)
+			continue
+		}
+		// the pods are only known by the labels the selector requires, the ones selected by expressions are skipped
+		if podSelector == nil || len(podSelector.MatchLabels) == 0 {
+			continue
+		}
+		if namespacePolicies == nil {
+			namespacePolicies = &networkingv1.NetworkPolicyList{}
+			if err = n.C.List(ctx, namespacePolicies, client.InNamespace(workflow.Namespace)); err != nil {
+				return nil, err
+			}
+		}
+		if !networkpolicy.IsIngressIsolated(podSelector.MatchLabels, namespacePolicies.Items) {
+			klog.V(log.I).InfoS("Skipping the NetworkPolicy for a discovered service whose pods aren't isolated", "uri", reference.Uri)
+			continue
+		}
+		names[name] = true
+		policies = append(policies, &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: workflow.Namespace,
+				Labels:    map[string]string{networkpolicy.LabelDiscoveryPolicy: "true"},
+			},
+			Spec: networkpolicy.NewIngressSpec(*podSelector, nil, networkpolicy.NewWorkflowPeer(workflow)),
+		})
+	}
+	return policies, nil
+}
+
+// deleteUnusedNetworkPolicies deletes the NetworkPolicies controlled by the workflow other than the given ones.
+func (n *networkPolicyManager) deleteUnusedNetworkPolicies(ctx context.Context, workflow *operatorapi.SonataFlow, policies []*networkingv1.NetworkPolicy) error {
+	keep := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		keep[policy.Name] = true
+	}
+	list := &networkingv1.NetworkPolicyList{}
+	if err := n.C.List(ctx, list, client.InNamespace(workflow.Namespace), client.MatchingLabels{workflowproj.LabelWorkflow: workflow.Name}); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		policy := &list.Items[i]
+		if keep[policy.Name] || !metav1.IsControlledBy(policy, workflow) {
+			continue
+		}
+		klog.V(log.I).InfoS("Removing the NetworkPolicy since it's no longer needed by the workflow", "name", policy.Name)
+		if err := n.C.Delete(ctx, policy); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/networkpolicy"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+func TestNetworkPolicyHandler(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Status.DiscoveryReferences = []v1alpha08.DiscoveryReferenceStatus{
+		{Source: "billing", Uri: "kubernetes:services.v1/billing", Address: "http://billing." + t.Name() + ".svc:80"},
+		{Source: "shipping", Uri: "kubernetes:services.v1/logistics/shipping", Address: "http://shipping.logistics.svc:80"},
+		{Source: "missing", Uri: "kubernetes:services.v1/missing", Error: "services \"missing\" not found"},
+	}
+	platform := test.GetBasePlatform()
+	platform.Namespace = t.Name()
+	platform.Spec.NetworkPolicy = &v1alpha08.PlatformNetworkPolicySpec{Enabled: true}
+	platform.Spec.Services = &v1alpha08.ServicesPlatformSpec{
+		JobService: &v1alpha08.JobServiceServiceSpec{ServiceSpec: v1alpha08.ServiceSpec{Enabled: ptr.To(true)}},
+	}
+	billing := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "billing", Namespace: t.Name()},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "billing"}},
+	}
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, billing).Build()
+
+	// the billing pods aren't isolated, an ingress policy would cut off their other clients
+	objs, err := NewNetworkPolicyHandler(&StateSupport{C: cli}, platform).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	denyAll := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: t.Name()},
+		Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+	}
+	assert.NoError(t, cli.Create(context.TODO(), denyAll))
+	objs, err = NewNetworkPolicyHandler(&StateSupport{C: cli}, platform).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+
+	policy := objs[0].(*networkingv1.NetworkPolicy)
+	assert.Equal(t, workflow.Name, policy.Name)
+	assert.Equal(t, networkpolicy.GetWorkflowPodLabels(workflow), policy.Spec.PodSelector.MatchLabels)
+	assert.Len(t, policy.Spec.Ingress, 1)
+	assert.Len(t, policy.Spec.Ingress[0].From, 1)
+	assert.Equal(t, map[string]string{workflowproj.LabelService: platform.Name + "-jobs-service"}, policy.Spec.Ingress[0].From[0].PodSelector.MatchLabels)
+	assert.Len(t, policy.Spec.Ingress[0].Ports, 1)
+
+	policy = objs[1].(*networkingv1.NetworkPolicy)
+	assert.Equal(t, workflow.Name+"-service-billing", policy.Name)
+	assert.Equal(t, billing.Spec.Selector, policy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, networkpolicy.GetWorkflowPodLabels(workflow), policy.Spec.Ingress[0].From[0].PodSelector.MatchLabels)
+	assert.Empty(t, policy.Spec.Ingress[0].Ports)
+	assert.Equal(t, "true", policy.Labels[networkpolicy.LabelDiscoveryPolicy])
+
+	platform.Spec.NetworkPolicy.Enabled = false
+	objs, err = NewNetworkPolicyHandler(&StateSupport{C: cli}, platform).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Empty(t, objs)
+	policies := &networkingv1.NetworkPolicyList{}
+	assert.NoError(t, cli.List(context.TODO(), policies, client.InNamespace(t.Name())))
+	assert.Len(t, policies.Items, 1)
+	assert.Equal(t, denyAll.Name, policies.Items[0].Name)
+}
+
+func TestIsIngressIsolated(t *testing.T) {
+	podLabels := map[string]string{"app": "billing"}
+	egressOnly := networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}}}
+	otherApp := networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "shipping"}}}}
+	discovery := networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{networkpolicy.LabelDiscoveryPolicy: "true"}}}
+	assert.False(t, networkpolicy.IsIngressIsolated(podLabels, []networkingv1.NetworkPolicy{egressOnly, otherApp, discovery}))
+
+	billing := networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{PodSelector: metav1.LabelSelector{MatchLabels: podLabels}}}
+	assert.True(t, networkpolicy.IsIngressIsolated(podLabels, []networkingv1.NetworkPolicy{otherApp, billing}))
+}
@@ -0,0 +1,163 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package networkpolicy builds the NetworkPolicies allowing the traffic between the workflows and the supporting
+// services deployed in a platform namespace with the NetworkPolicy generation enabled.
+package networkpolicy
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	// DefaultEventingNamespace is the namespace of the Knative Eventing data plane delivering the broker events.
+	DefaultEventingNamespace = "knative-eventing"
+	// LabelDiscoveryPolicy marks the NetworkPolicies admitting a workflow to the pods of a service it discovered.
+	LabelDiscoveryPolicy = metadata.Domain + "/discovery-policy"
+	namespaceNameLabel   = "kubernetes.io/metadata.name"
+)
+
+// IsEnabled returns true if the given platform generates the NetworkPolicies of its namespace.
+func IsEnabled(platform *operatorapi.SonataFlowPlatform) bool {
+	return platform != nil && platform.Spec.NetworkPolicy != nil && platform.Spec.NetworkPolicy.Enabled
+}
+
+// IsEnabledForWorkflow returns true if the NetworkPolicies must be generated for the given workflow.
+// Dev profile workflows are never restricted.
+func IsEnabledForWorkflow(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return IsEnabled(platform) && !profiles.IsDevProfile(workflow)
+}
+
+// GetEventingNamespace returns the namespace the broker events are delivered from.
+func GetEventingNamespace(platform *operatorapi.SonataFlowPlatform) string {
+	if len(platform.Spec.NetworkPolicy.EventingNamespace) > 0 {
+		return platform.Spec.NetworkPolicy.EventingNamespace
+	}
+	return DefaultEventingNamespace
+}
+
+// NewEventingPeer returns the peer matching the Knative Eventing data plane pods delivering the broker events.
+func NewEventingPeer(platform *operatorapi.SonataFlowPlatform) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{NamespaceSelector: newNamespaceSelector(GetEventingNamespace(platform))}
+}
+
+// NewWorkflowsPeer returns the peer matching the pods of every workflow in the cluster, since the platform services
+// can also be shared with the workflows of other namespaces.
+func NewWorkflowsPeer() networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{},
+		PodSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: workflowproj.LabelWorkflow, Operator: metav1.LabelSelectorOpExists},
+			},
+		},
+	}
+}
+
+// NewWorkflowPeer returns the peer matching the pods of the given workflow, in its own namespace.
+func NewWorkflowPeer(workflow *operatorapi.SonataFlow) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: GetWorkflowPodLabels(workflow)}}
+}
+
+// NewPodsPeer returns the peer matching the pods with the given labels in the given namespace.
+func NewPodsPeer(namespace string, labels map[string]string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: newNamespaceSelector(namespace),
+		PodSelector:       &metav1.LabelSelector{MatchLabels: labels},
+	}
+}
+
+// GetWorkflowPodLabels returns the labels identifying the pods of the given workflow.
+func GetWorkflowPodLabels(workflow *operatorapi.SonataFlow) map[string]string {
+	return map[string]string{
+		workflowproj.LabelWorkflow:          workflow.Name,
+		workflowproj.LabelWorkflowNamespace: workflow.Namespace,
+	}
+}
+
+// NewHTTPPorts returns the ports the workflows and the platform services listen to HTTP requests on.
+func NewHTTPPorts(platform *operatorapi.SonataFlowPlatform) []networkingv1.NetworkPolicyPort {
+	ports := []networkingv1.NetworkPolicyPort{newTCPPort(constants.DefaultHTTPWorkflowPortInt)}
+	if tls.IsEnabled(platform) {
+		ports = append(ports, newTCPPort(tls.HTTPSContainerPort))
+	}
+	return ports
+}
+
+// IsIngressIsolated returns true if one of the given NetworkPolicies, other than the discovery ones, isolates the pods
+// with the given labels for ingress. Admitting a workflow to those pods then leaves their other clients untouched.
+func IsIngressIsolated(podLabels map[string]string, policies []networkingv1.NetworkPolicy) bool {
+	for i := range policies {
+		policy := &policies[i]
+		if _, ok := policy.Labels[LabelDiscoveryPolicy]; ok || !hasIngressPolicyType(policy) {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err == nil && selector.Matches(labels.Set(podLabels)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIngressPolicyType(policy *networkingv1.NetworkPolicy) bool {
+	// policies without types always affect the ingress traffic
+	if len(policy.Spec.PolicyTypes) == 0 {
+		return true
+	}
+	for _, policyType := range policy.Spec.PolicyTypes {
+		if policyType == networkingv1.PolicyTypeIngress {
+			return true
+		}
+	}
+	return false
+}
+
+// NewIngressSpec returns the spec of a NetworkPolicy allowing the selected pods to receive the traffic of the given
+// peers on the given ports, or on any port if none is given.
+func NewIngressSpec(podSelector metav1.LabelSelector, ports []networkingv1.NetworkPolicyPort, peers ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicySpec {
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: podSelector,
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{Ports: ports, From: peers},
+		},
+	}
+}
+
+func newNamespaceSelector(namespace string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: namespace}}
+}
+
+func newTCPPort(port int) networkingv1.NetworkPolicyPort {
+	protocol := corev1.ProtocolTCP
+	portValue := intstr.FromInt32(int32(port))
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &portValue}
+}
@@ -22,10 +22,12 @@ package common
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles"
 
+	knativeautoscaling "knative.dev/serving/pkg/apis/autoscaling"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
@@ -33,10 +35,13 @@ import (
 	"github.com/imdario/mergo"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -47,6 +52,7 @@ import (
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/properties"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/variables"
@@ -126,6 +132,7 @@ func DeploymentCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.Sonata
 		return nil, err
 	}
 	kubeutil.AddOrReplaceContainer(operatorapi.DefaultContainerName, *flowContainer, &deployment.Spec.Template.Spec)
+	kubeutil.SetDefaultTopologySpreadConstraints(workflow.Spec.PodTemplate.TopologySpread, deployment.Spec.Selector.MatchLabels, &deployment.Spec.Template.Spec)
 
 	return deployment, nil
 }
@@ -161,12 +168,55 @@ func KServiceCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 		return nil, err
 	}
 	kubeutil.AddOrReplaceContainer(operatorapi.DefaultContainerName, *flowContainer, &ksvc.Spec.Template.Spec.PodSpec)
+	if autoscalingAnnotations := getKnativeAutoscalingAnnotations(workflow); len(autoscalingAnnotations) > 0 {
+		ksvc.Spec.Template.Annotations = autoscalingAnnotations
+	}
 
 	return ksvc, nil
 }
 
+// getKnativeAutoscalingAnnotations translates the workflow autoscaling settings into Knative Serving revision annotations.
+// Knative scales on a single metric, the first one defined among CPU, memory and the custom metrics is used.
+func getKnativeAutoscalingAnnotations(workflow *operatorapi.SonataFlow) map[string]string {
+	spec := workflow.Spec.PodTemplate.Autoscaling
+	if spec == nil {
+		return nil
+	}
+	annotations := map[string]string{
+		knativeautoscaling.MaxScaleAnnotationKey: strconv.Itoa(int(spec.MaxReplicas)),
+	}
+	if spec.MinReplicas != nil {
+		annotations[knativeautoscaling.MinScaleAnnotationKey] = strconv.Itoa(int(*spec.MinReplicas))
+	}
+	memoryRequest := workflow.Spec.PodTemplate.Container.Resources.Requests.Memory()
+	switch {
+	case spec.TargetCPUUtilizationPercentage != nil:
+		annotations[knativeautoscaling.ClassAnnotationKey] = knativeautoscaling.HPA
+		annotations[knativeautoscaling.MetricAnnotationKey] = knativeautoscaling.CPU
+		annotations[knativeautoscaling.TargetAnnotationKey] = strconv.Itoa(int(*spec.TargetCPUUtilizationPercentage))
+	case spec.TargetMemoryUtilizationPercentage != nil && !memoryRequest.IsZero():
+		// Knative expects the memory target in Mi
+		annotations[knativeautoscaling.ClassAnnotationKey] = knativeautoscaling.HPA
+		annotations[knativeautoscaling.MetricAnnotationKey] = knativeautoscaling.Memory
+		annotations[knativeautoscaling.TargetAnnotationKey] = strconv.FormatInt(memoryRequest.Value()*int64(*spec.TargetMemoryUtilizationPercentage)/100/(1024*1024), 10)
+	default:
+		for _, metric := range spec.Metrics {
+			if metric.Name == knativeautoscaling.Concurrency || metric.Name == knativeautoscaling.RPS {
+				annotations[knativeautoscaling.MetricAnnotationKey] = metric.Name
+				annotations[knativeautoscaling.TargetAnnotationKey] = strconv.FormatInt(metric.TargetAverageValue.Value(), 10)
+				break
+			}
+		}
+	}
+	return annotations
+}
+
+// getReplicasOrDefault returns nil when the replicas are owned by the workflow HorizontalPodAutoscaler.
 func getReplicasOrDefault(workflow *operatorapi.SonataFlow) *int32 {
 	var dReplicas int32 = 1
+	if workflow.Spec.PodTemplate.Autoscaling != nil {
+		return nil
+	}
 	if workflow.Spec.PodTemplate.Replicas == nil {
 		return &dReplicas
 	}
@@ -222,11 +272,7 @@ func defaultContainer(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataF
 		return nil, err
 	}
 	if !profiles.IsDevProfile(workflow) {
-		var pper *operatorapi.PlatformPersistenceOptionsSpec
-		if plf != nil && plf.Spec.Persistence != nil {
-			pper = plf.Spec.Persistence
-		}
-		if p := persistence.RetrieveConfiguration(workflow.Spec.Persistence, pper, workflow.Name); p != nil {
+		if p := persistence.ResolveWorkflowProvider(workflow, plf); p != nil {
 			defaultFlowContainer = persistence.ConfigureWorkflowPersistence(defaultFlowContainer, p, workflow.Name, workflow.Namespace)
 		}
 	}
@@ -342,6 +388,10 @@ func getBrokerRefFromPlatform(plf *operatorapi.SonataFlowPlatform, checkRemote b
 }
 
 func getBrokerRefForEventType(eventType string, workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (*duckv1.KReference, error) {
+	// the workflows using the Kafka eventing consume their events from the topics
+	if kafka.IsEnabled(workflow, plf) {
+		return nil, nil
+	}
 	// Check the workflow
 	for _, source := range workflow.Spec.Sources {
 		if source.EventType == eventType {
@@ -356,6 +406,36 @@ func getBrokerRefForEventType(eventType string, workflow *operatorapi.SonataFlow
 	return getBrokerRefFromPlatform(plf, true)
 }
 
+func getDeliveryFromPlatform(plf *operatorapi.SonataFlowPlatform, checkRemote bool) (*eventingduckv1.DeliverySpec, error) {
+	// check the local platform
+	if plf.Spec.Eventing != nil && plf.Spec.Eventing.Delivery != nil {
+		return knative.GetDeliverySpec(plf.Spec.Eventing.Delivery, plf.Namespace), nil
+	}
+	// Check the cluster platform
+	if checkRemote && plf.Status.ClusterPlatformRef != nil && len(plf.Status.ClusterPlatformRef.PlatformRef.Name) > 0 {
+		platform := &operatorapi.SonataFlowPlatform{}
+		if err := utils.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: plf.Status.ClusterPlatformRef.PlatformRef.Namespace, Name: plf.Status.ClusterPlatformRef.PlatformRef.Name}, platform); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return getDeliveryFromPlatform(platform, false)
+	}
+	return nil, nil
+}
+
+func getDeliveryForEventType(eventType string, workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (*eventingduckv1.DeliverySpec, error) {
+	// Check the workflow
+	for _, source := range workflow.Spec.Sources {
+		if source.EventType == eventType && source.Delivery != nil {
+			return knative.GetDeliverySpec(source.Delivery, workflow.Namespace), nil
+		}
+	}
+	// get the delivery from the local platform or cluster platform
+	return getDeliveryFromPlatform(plf, true)
+}
+
 // TriggersCreator is an ObjectsCreator for Triggers.
 // It will create a list of eventingv1.Trigger based on events defined in workflow.
 func TriggersCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) ([]client.Object, error) {
@@ -386,6 +466,10 @@ func TriggersCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 		if _, err := knative.ValidateBroker(brokerRef.Name, brokerRef.Namespace); err != nil {
 			return nil, err
 		}
+		delivery, err := getDeliveryForEventType(event.Type, workflow, plf)
+		if err != nil {
+			return nil, err
+		}
 		// construct eventingv1.Trigger
 		// The trigger must be created in the same namespace as the broker
 		trigger := &eventingv1.Trigger{
@@ -409,6 +493,7 @@ func TriggersCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 						Kind:       kind,
 					},
 				},
+				Delivery: delivery,
 			},
 		}
 		resultObjects = append(resultObjects, trigger)
@@ -438,6 +523,75 @@ func ManagedPropsConfigMapCreator(workflow *operatorapi.SonataFlow, platform *op
 	return workflowproj.CreateNewManagedPropsConfigMap(workflow, props), nil
 }
 
+// HorizontalPodAutoscalerCreator is an ObjectCreator for the HorizontalPodAutoscaler targeting the workflow Deployment.
+// Returns nil if the workflow doesn't configure the autoscaling.
+func HorizontalPodAutoscalerCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
+	spec := workflow.Spec.PodTemplate.Autoscaling
+	if spec == nil {
+		return nil, nil
+	}
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    workflowproj.GetMergedLabels(workflow),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: deploymentAPIVersion,
+				Kind:       deploymentKind,
+				Name:       workflow.Name,
+			},
+			MinReplicas: spec.MinReplicas,
+			MaxReplicas: spec.MaxReplicas,
+		},
+	}
+	if spec.TargetCPUUtilizationPercentage != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceUtilizationMetric(corev1.ResourceCPU, *spec.TargetCPUUtilizationPercentage))
+	}
+	if spec.TargetMemoryUtilizationPercentage != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, resourceUtilizationMetric(corev1.ResourceMemory, *spec.TargetMemoryUtilizationPercentage))
+	}
+	for _, metric := range spec.Metrics {
+		target := metric.TargetAverageValue.DeepCopy()
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: metric.Name},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &target},
+			},
+		})
+	}
+	return hpa, nil
+}
+
+// PodDisruptionBudgetCreator is an ObjectCreator for the PodDisruptionBudget protecting the workflow Deployment pods.
+// Returns nil if the workflow doesn't configure a disruption budget.
+func PodDisruptionBudgetCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.PodTemplate.DisruptionBudget == nil {
+		return nil, nil
+	}
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    workflowproj.GetMergedLabels(workflow),
+		},
+		Spec: kubeutil.NewPodDisruptionBudgetSpec(workflow.Spec.PodTemplate.DisruptionBudget, workflowproj.GetSelectorLabels(workflow)),
+	}
+	return pdb, nil
+}
+
+func resourceUtilizationMetric(resourceName corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name:   resourceName,
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+		},
+	}
+}
+
 // ServiceMonitorCreator is an ObjectsCreator for Service Monitor for the workflow service.
 func ServiceMonitorCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
 	lbl := workflowproj.GetMergedLabels(workflow)
@@ -29,12 +29,20 @@ import (
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
+	knativeautoscaling "knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
@@ -379,6 +387,48 @@ func TestEnsureWorkflowTriggersWithWorkflowBrokerAreCreated(t *testing.T) {
 	assert.Equal(t, trigger.Spec.Filter.Attributes["type"], "events.vet.appointments")
 }
 
+func TestEnsureWorkflowTriggersDelivery(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sources[0].Destination.Ref.Namespace = workflow.Namespace
+	workflow.Spec.Sources[1].Destination.Ref.Namespace = workflow.Namespace
+	workflow.Spec.Sources[0].Delivery = &v1alpha08.EventDeliverySpec{
+		Retry:          ptr.To(int32(5)),
+		DeadLetterSink: &duckv1.Destination{Ref: &duckv1.KReference{APIVersion: "v1", Kind: "Service", Name: "appointments-dls"}},
+	}
+	plf := test.GetBasePlatform()
+	plf.Spec.Eventing = &v1alpha08.PlatformEventingSpec{
+		Delivery: &v1alpha08.EventDeliverySpec{
+			Retry:         ptr.To(int32(3)),
+			BackoffPolicy: ptr.To(v1alpha08.BackoffPolicyExponential),
+			BackoffDelay:  ptr.To("PT1S"),
+		},
+	}
+	broker1 := test.GetDefaultBroker(workflow.Namespace)
+	broker1.Name = "broker-appointments-request"
+	broker2 := test.GetDefaultBroker(workflow.Namespace)
+	broker2.Name = "broker-appointments"
+	cl := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, plf, broker1, broker2).WithStatusSubresource(workflow, plf, broker1, broker2).Build()
+	utils.SetClient(cl)
+
+	triggers, err := TriggersCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Len(t, triggers, 2)
+	// the source delivery wins over the platform one
+	trigger := getTrigger(kmeta.ChildName("vet-vetappointmentinfo-", string(workflow.GetUID())), triggers)
+	assert.NotNil(t, trigger.Spec.Delivery)
+	assert.Equal(t, int32(5), *trigger.Spec.Delivery.Retry)
+	assert.Nil(t, trigger.Spec.Delivery.BackoffPolicy)
+	assert.Equal(t, "appointments-dls", trigger.Spec.Delivery.DeadLetterSink.Ref.Name)
+	assert.Equal(t, workflow.Namespace, trigger.Spec.Delivery.DeadLetterSink.Ref.Namespace)
+	// the platform delivery is the default
+	trigger = getTrigger(kmeta.ChildName("vet-vetappointmentrequestreceived-", string(workflow.GetUID())), triggers)
+	assert.NotNil(t, trigger.Spec.Delivery)
+	assert.Equal(t, int32(3), *trigger.Spec.Delivery.Retry)
+	assert.Equal(t, eventingduckv1.BackoffPolicyExponential, *trigger.Spec.Delivery.BackoffPolicy)
+	assert.Equal(t, "PT1S", *trigger.Spec.Delivery.BackoffDelay)
+	assert.Nil(t, trigger.Spec.Delivery.DeadLetterSink)
+}
+
 func TestEnsureWorkflowTriggersWithoutBrokerAreNotCreated(t *testing.T) {
 	workflow := test.GetVetEventSonataFlow(t.Name())
 	workflow.Spec.Sink = nil
@@ -984,3 +1034,93 @@ func TestEnsureWorkflowServiceMonitorIsCreatedWhenDeployedAsDeployment(t *testin
 		"app.kubernetes.io/component":       "serverless-workflow",
 		"app.kubernetes.io/managed-by":      "sonataflow-operator"})
 }
+
+func TestHorizontalPodAutoscalerCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	hpa, err := HorizontalPodAutoscalerCreator(workflow)
+	assert.NoError(t, err)
+	assert.Nil(t, hpa)
+
+	workflow.Spec.PodTemplate.Replicas = ptr.To(int32(3))
+	workflow.Spec.PodTemplate.Autoscaling = &v1alpha08.AutoscalingSpec{
+		MinReplicas:                    ptr.To(int32(2)),
+		MaxReplicas:                    5,
+		TargetCPUUtilizationPercentage: ptr.To(int32(70)),
+		Metrics:                        []v1alpha08.AutoscalingMetricSpec{{Name: "http_requests", TargetAverageValue: resource.MustParse("100")}},
+	}
+	hpa, err = HorizontalPodAutoscalerCreator(workflow)
+	assert.NoError(t, err)
+	spec := hpa.(*autoscalingv2.HorizontalPodAutoscaler).Spec
+	assert.Equal(t, workflow.Name, spec.ScaleTargetRef.Name)
+	assert.Equal(t, "Deployment", spec.ScaleTargetRef.Kind)
+	assert.Equal(t, int32(2), *spec.MinReplicas)
+	assert.Equal(t, int32(5), spec.MaxReplicas)
+	assert.Len(t, spec.Metrics, 2)
+	assert.Equal(t, corev1.ResourceCPU, spec.Metrics[0].Resource.Name)
+	assert.Equal(t, int32(70), *spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, "http_requests", spec.Metrics[1].Pods.Metric.Name)
+
+	// the replicas are owned by the HorizontalPodAutoscaler
+	deployment, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, deployment.(*appsv1.Deployment).Spec.Replicas)
+}
+
+func TestKServiceCreator_AutoscalingAnnotations(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.PodTemplate.Container.Resources.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")}
+	workflow.Spec.PodTemplate.Autoscaling = &v1alpha08.AutoscalingSpec{
+		MinReplicas:                       ptr.To(int32(1)),
+		MaxReplicas:                       4,
+		TargetMemoryUtilizationPercentage: ptr.To(int32(50)),
+	}
+	ksvc, err := KServiceCreator(workflow, nil)
+	assert.NoError(t, err)
+	annotations := ksvc.(*servingv1.Service).Spec.Template.Annotations
+	assert.Equal(t, "1", annotations[knativeautoscaling.MinScaleAnnotationKey])
+	assert.Equal(t, "4", annotations[knativeautoscaling.MaxScaleAnnotationKey])
+	assert.Equal(t, knativeautoscaling.HPA, annotations[knativeautoscaling.ClassAnnotationKey])
+	assert.Equal(t, knativeautoscaling.Memory, annotations[knativeautoscaling.MetricAnnotationKey])
+	assert.Equal(t, "256", annotations[knativeautoscaling.TargetAnnotationKey])
+
+	// the annotations are removed once the autoscaling is disabled
+	live := ksvc.(*servingv1.Service).DeepCopy()
+	live.SetResourceVersion("1")
+	workflow.Spec.PodTemplate.Autoscaling = nil
+	assert.NoError(t, KServiceMutateVisitor(workflow, nil)(live)())
+	assert.NotContains(t, live.Spec.Template.Annotations, knativeautoscaling.MaxScaleAnnotationKey)
+}
+
+func TestPodDisruptionBudgetCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	pdb, err := PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	assert.Nil(t, pdb)
+
+	minAvailable := intstr.FromInt32(2)
+	workflow.Spec.PodTemplate.DisruptionBudget = &v1alpha08.DisruptionBudgetSpec{MinAvailable: &minAvailable}
+	pdb, err = PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	spec := pdb.(*policyv1.PodDisruptionBudget).Spec
+	assert.Equal(t, minAvailable, *spec.MinAvailable)
+	assert.Nil(t, spec.MaxUnavailable)
+	assert.Equal(t, workflowproj.GetSelectorLabels(workflow), spec.Selector.MatchLabels)
+}
+
+func TestDeploymentCreator_TopologySpread(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.TopologySpread = &v1alpha08.TopologySpreadSpec{TopologyKeys: []string{corev1.LabelHostname}}
+	object, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	deployment := object.(*appsv1.Deployment)
+	assert.Len(t, deployment.Spec.Template.Spec.TopologySpreadConstraints, 1)
+	assert.Equal(t, corev1.LabelHostname, deployment.Spec.Template.Spec.TopologySpreadConstraints[0].TopologyKey)
+	assert.Equal(t, deployment.Spec.Selector.MatchLabels, deployment.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector.MatchLabels)
+
+	// the constraints are removed from the live deployment once the spread is disabled
+	workflow.Spec.PodTemplate.TopologySpread = nil
+	deployment.SetResourceVersion("1")
+	assert.NoError(t, DeploymentMutateVisitor(workflow, nil)(deployment)())
+	assert.Empty(t, deployment.Spec.Template.Spec.TopologySpreadConstraints)
+}
@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+)
+
+// mySQLProvider is the Provider for the mysql persistence, MariaDB servers are supported through the same driver.
+// MySQL treats schemas and databases as synonyms, so the schema is always the database name.
+type mySQLProvider struct {
+	config *operatorapi.PersistenceMySQL
+}
+
+// GetMySQLExtensions returns the Quarkus extensions required for mysql persistence.
+func GetMySQLExtensions() []cfg.GroupArtifactId {
+	return cfg.GetCfg().MySQLPersistenceExtensions
+}
+
+func (p *mySQLProvider) GetType() constants.PersistenceType {
+	return constants.PersistenceTypeMySQL
+}
+
+func (p *mySQLProvider) GetJdbcUrl(_, namespace string) string {
+	if p.config.ServiceRef == nil {
+		return p.config.JdbcUrl
+	}
+	dataSourcePort := constants.DefaultMySQLPort
+	if len(p.config.ServiceRef.Namespace) > 0 {
+		namespace = p.config.ServiceRef.Namespace
+	}
+	if p.config.ServiceRef.Port != nil {
+		dataSourcePort = *p.config.ServiceRef.Port
+	}
+	return fmt.Sprintf("jdbc:mysql://%s.%s:%d/%s", p.config.ServiceRef.Name, namespace, dataSourcePort, p.GetSchemaName(defaultDatabaseName))
+}
+
+func (p *mySQLProvider) GetReactiveUrl(defaultSchema, namespace string) string {
+	return strings.TrimPrefix(p.GetJdbcUrl(defaultSchema, namespace), "jdbc:")
+}
+
+// GetSchemaName returns the database name declared in the ServiceRef or in the JdbcUrl path, defaultSchema otherwise.
+func (p *mySQLProvider) GetSchemaName(defaultSchema string) string {
+	if p.config.ServiceRef != nil {
+		if len(p.config.ServiceRef.DatabaseName) > 0 {
+			return p.config.ServiceRef.DatabaseName
+		}
+		return defaultDatabaseName
+	}
+	// jdbc:mysql://host:port/database?param=value
+	_, address, found := strings.Cut(p.config.JdbcUrl, "://")
+	if !found {
+		return defaultSchema
+	}
+	_, path, found := strings.Cut(address, "/")
+	if !found {
+		return defaultSchema
+	}
+	database, _, _ := strings.Cut(path, "?")
+	if len(database) == 0 {
+		return defaultSchema
+	}
+	return database
+}
+
+func (p *mySQLProvider) GetSecretRef() (name, userKey, passwordKey string) {
+	userKey = "MYSQL_USER"
+	if len(p.config.SecretRef.UserKey) > 0 {
+		userKey = p.config.SecretRef.UserKey
+	}
+	passwordKey = "MYSQL_PASSWORD"
+	if len(p.config.SecretRef.PasswordKey) > 0 {
+		passwordKey = p.config.SecretRef.PasswordKey
+	}
+	return p.config.SecretRef.Name, userKey, passwordKey
+}
+
+func (p *mySQLProvider) GetExtensions() []cfg.GroupArtifactId {
+	return GetMySQLExtensions()
+}
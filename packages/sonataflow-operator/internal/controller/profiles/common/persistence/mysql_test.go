@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+)
+
+func TestMySQLProvider_WithServiceRef(t *testing.T) {
+	provider := NewProvider(&operatorapi.PersistenceOptionsSpec{
+		MySQL: &operatorapi.PersistenceMySQL{
+			SecretRef:  operatorapi.MySQLSecretOptions{Name: "mysql-secret"},
+			ServiceRef: &operatorapi.SQLServiceOptions{Name: "mysql", Port: pointer.Int(3307), DatabaseName: "workflows"},
+		},
+	})
+	assert.Equal(t, constants.PersistenceTypeMySQL, provider.GetType())
+	assert.Equal(t, "jdbc:mysql://mysql.default:3307/workflows", provider.GetJdbcUrl("greeting", "default"))
+	assert.Equal(t, "mysql://mysql.default:3307/workflows", provider.GetReactiveUrl("greeting", "default"))
+	assert.Equal(t, "workflows", provider.GetSchemaName("greeting"))
+
+	name, userKey, passwordKey := provider.GetSecretRef()
+	assert.Equal(t, "mysql-secret", name)
+	assert.Equal(t, "MYSQL_USER", userKey)
+	assert.Equal(t, "MYSQL_PASSWORD", passwordKey)
+}
+
+func TestMySQLProvider_WithJdbcUrl(t *testing.T) {
+	provider := NewProvider(&operatorapi.PersistenceOptionsSpec{
+		MySQL: &operatorapi.PersistenceMySQL{
+			SecretRef: operatorapi.MySQLSecretOptions{Name: "mysql-secret", UserKey: "user", PasswordKey: "pass"},
+			JdbcUrl:   "jdbc:mariadb://mariadb:3306/sonataflow?useSSL=false",
+		},
+	})
+	assert.Equal(t, "jdbc:mariadb://mariadb:3306/sonataflow?useSSL=false", provider.GetJdbcUrl("greeting", "default"))
+	assert.Equal(t, "sonataflow", provider.GetSchemaName("greeting"))
+
+	env := ConfigureEnv(provider, "greeting", "default", false)
+	assert.Contains(t, env, corev1.EnvVar{Name: "QUARKUS_DATASOURCE_DB_KIND", Value: "mysql"})
+	assert.Equal(t, "user", env[0].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "pass", env[1].ValueFrom.SecretKeyRef.Key)
+}
+
+func TestResolveServiceProvider_PrefersServicePersistence(t *testing.T) {
+	platformPersistence := &operatorapi.PlatformPersistenceOptionsSpec{
+		PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{
+			SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "postgres-secret"},
+			ServiceRef: &operatorapi.SQLServiceOptions{Name: "postgres"},
+		},
+	}
+	service := &operatorapi.PersistenceOptionsSpec{
+		MySQL: &operatorapi.PersistenceMySQL{
+			SecretRef:  operatorapi.MySQLSecretOptions{Name: "mysql-secret"},
+			ServiceRef: &operatorapi.SQLServiceOptions{Name: "mysql"},
+		},
+	}
+	assert.Equal(t, constants.PersistenceTypeMySQL, ResolveServiceProvider(service, platformPersistence, "data-index").GetType())
+	provider := ResolveServiceProvider(&operatorapi.PersistenceOptionsSpec{DBMigrationStrategy: "job"}, platformPersistence, "data-index")
+	assert.Equal(t, constants.PersistenceTypePostgreSQL, provider.GetType())
+	assert.Equal(t, "jdbc:postgresql://postgres.default:5432/sonataflow?currentSchema=data-index", provider.GetJdbcUrl("data-index", "default"))
+	assert.Nil(t, ResolveServiceProvider(nil, nil, "data-index"))
+}
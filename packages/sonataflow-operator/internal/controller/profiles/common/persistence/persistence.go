@@ -19,8 +19,12 @@ package persistence
 
 import (
 	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
 )
 
 const (
@@ -32,13 +36,140 @@ const (
 	KogitoPersistenceQueryTimeoutMillis string = "kogito.persistence.query.timeout.millis"
 	KogitoPersistenceProtoMarshaller    string = "kogito.persistence.proto.marshaller"
 	PostgreSQLDBKind                    string = "postgresql"
+	MySQLDBKind                         string = "mysql"
 )
 
+// Provider abstracts the database specifics required to configure the persistence of the workflows and the platform services.
+type Provider interface {
+	// GetType returns the persistence type, also used as the Quarkus datasource db-kind.
+	GetType() constants.PersistenceType
+	// GetJdbcUrl returns the JDBC url to connect to the database. The defaultSchema and namespace are used when the
+	// configuration refers to a Kubernetes service that doesn't declare them.
+	GetJdbcUrl(defaultSchema, namespace string) string
+	// GetReactiveUrl returns the url used by the reactive clients, for example, the Jobs Service.
+	GetReactiveUrl(defaultSchema, namespace string) string
+	// GetSchemaName returns the database schema, defaultSchema if the configuration doesn't declare any.
+	GetSchemaName(defaultSchema string) string
+	// GetSecretRef returns the name of the credentials secret along with the user and password keys.
+	GetSecretRef() (name, userKey, passwordKey string)
+	// GetExtensions returns the Quarkus extensions required at build time by the workflows.
+	GetExtensions() []cfg.GroupArtifactId
+}
+
+// NewProvider returns the Provider for the database configured in the given PersistenceOptionsSpec, nil if none.
+func NewProvider(config *operatorapi.PersistenceOptionsSpec) Provider {
+	if config == nil {
+		return nil
+	}
+	if config.PostgreSQL != nil {
+		return &postgreSQLProvider{config: config.PostgreSQL}
+	}
+	if config.MySQL != nil {
+		return &mySQLProvider{config: config.MySQL}
+	}
+	return nil
+}
+
+// ResolveWorkflowProvider returns the Provider for the given workflow, falling back to the platform persistence when
+// the workflow doesn't declare one. Returns nil if the workflow is not persistent.
+func ResolveWorkflowProvider(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) Provider {
+	var platformPersistence *operatorapi.PlatformPersistenceOptionsSpec
+	if platform != nil {
+		platformPersistence = platform.Spec.Persistence
+	}
+	return NewProvider(RetrieveConfiguration(workflow.Spec.Persistence, platformPersistence, workflow.Name))
+}
+
+// ResolveServiceProvider returns the Provider for a platform service, giving priority to the service configuration
+// over the platform one. Returns nil if none of them configures a database.
+func ResolveServiceProvider(service *operatorapi.PersistenceOptionsSpec, platformPersistence *operatorapi.PlatformPersistenceOptionsSpec, schema string) Provider {
+	if p := NewProvider(service); p != nil {
+		return p
+	}
+	if platformPersistence == nil {
+		return nil
+	}
+	return NewProvider(buildPersistenceOptionsSpec(platformPersistence, schema))
+}
+
+// ConfigureEnv returns the common env variables required by the workflows, the DataIndex or the JobsService to
+// connect to the database handled by the given Provider.
+func ConfigureEnv(provider Provider, defaultSchema, namespace string, includeReactiveUrl bool) []corev1.EnvVar {
+	secretName, userKey, passwordKey := provider.GetSecretRef()
+	secretRef := corev1.LocalObjectReference{
+		Name: secretName,
+	}
+	env := []corev1.EnvVar{
+		{
+			Name: "QUARKUS_DATASOURCE_USERNAME",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  userKey,
+					LocalObjectReference: secretRef,
+				},
+			},
+		},
+		{
+			Name: "QUARKUS_DATASOURCE_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  passwordKey,
+					LocalObjectReference: secretRef,
+				},
+			},
+		},
+		{
+			Name:  "QUARKUS_DATASOURCE_DB_KIND",
+			Value: provider.GetType().String(),
+		},
+		{
+			Name:  "QUARKUS_DATASOURCE_JDBC_URL",
+			Value: provider.GetJdbcUrl(defaultSchema, namespace),
+		},
+	}
+	if includeReactiveUrl {
+		env = append(env, corev1.EnvVar{
+			Name:  "QUARKUS_DATASOURCE_REACTIVE_URL",
+			Value: provider.GetReactiveUrl(defaultSchema, namespace),
+		})
+	}
+	env = append(env, corev1.EnvVar{
+		Name:  "KOGITO_PERSISTENCE_TYPE",
+		Value: JDBCPersistenceType,
+	})
+	return env
+}
+
+// ConfigureWorkflowPersistence adds the env variables required to connect to the database handled by the given Provider
+// to a copy of the workflow container.
+func ConfigureWorkflowPersistence(serviceContainer *corev1.Container, provider Provider, defaultSchema, namespace string) *corev1.Container {
+	if provider == nil {
+		return serviceContainer
+	}
+	c := serviceContainer.DeepCopy()
+	c.Env = append(c.Env, ConfigureEnv(provider, defaultSchema, namespace, false)...)
+	return c
+}
+
 // ResolveWorkflowPersistenceProperties returns the set of application properties required for the workflow persistence.
 // Never nil.
 func ResolveWorkflowPersistenceProperties(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) (*properties.Properties, error) {
-	if UsesPostgreSQLPersistence(workflow, platform) {
-		return GetPostgreSQLWorkflowProperties(workflow), nil
+	if provider := ResolveWorkflowProvider(workflow, platform); provider != nil {
+		return GetWorkflowProperties(workflow, provider), nil
 	}
 	return properties.NewProperties(), nil
 }
+
+// GetWorkflowProperties returns the set of application properties required for the workflow persistence.
+// Never nil.
+func GetWorkflowProperties(workflow *operatorapi.SonataFlow, provider Provider) *properties.Properties {
+	props := properties.NewProperties()
+	if !profiles.IsDevProfile(workflow) && !profiles.IsGitOpsProfile(workflow) {
+		// build-time property required by kogito-runtimes to feed flyway build-time settings and package the necessary .sql files.
+		props.Set(QuarkusDatasourceDBKind, provider.GetType().String())
+		// build-time properties for kogito-runtimes to use jdbc
+		props.Set(KogitoPersistenceType, JDBCPersistenceType)
+		props.Set(KogitoPersistenceProtoMarshaller, "false")
+	}
+	return props
+}
@@ -74,6 +74,22 @@ func TestResolveWorkflowPersistenceProperties_WithNoPersistence(t *testing.T) {
 	assert.Equal(t, 0, props.Len())
 }
 
+func TestResolveWorkflowPersistenceProperties_WithMySQLPlatformPersistence(t *testing.T) {
+	workflow := operatorapi.SonataFlow{}
+	platform := operatorapi.SonataFlowPlatform{
+		Spec: operatorapi.SonataFlowPlatformSpec{
+			Persistence: &operatorapi.PlatformPersistenceOptionsSpec{
+				MySQL: &operatorapi.PersistenceMySQL{},
+			},
+		},
+	}
+	props, err := ResolveWorkflowPersistenceProperties(&workflow, &platform)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, props.Len())
+	value, _ := props.Get("quarkus.datasource.db-kind")
+	assert.Equal(t, "mysql", value)
+}
+
 func testResolveWorkflowPersistencePropertiesWithPersistence(t *testing.T, workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) {
 	props, err := ResolveWorkflowPersistenceProperties(workflow, platform)
 	assert.Nil(t, err)
@@ -21,10 +21,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/magiconair/properties"
-
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
-	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -39,85 +36,62 @@ const (
 
 // ConfigurePostgreSQLEnv returns the common env variables required for the DataIndex or JobsService when postresql persistence is used.
 func ConfigurePostgreSQLEnv(postgresql *operatorapi.PersistencePostgreSQL, databaseSchema, databaseNamespace string, includeReactiveUrl bool) []corev1.EnvVar {
+	return ConfigureEnv(&postgreSQLProvider{config: postgresql}, databaseSchema, databaseNamespace, includeReactiveUrl)
+}
+
+// postgreSQLProvider is the Provider for the postgresql persistence.
+type postgreSQLProvider struct {
+	config *operatorapi.PersistencePostgreSQL
+}
+
+func (p *postgreSQLProvider) GetType() constants.PersistenceType {
+	return constants.PersistenceTypePostgreSQL
+}
+
+func (p *postgreSQLProvider) GetJdbcUrl(defaultSchema, namespace string) string {
+	if p.config.ServiceRef == nil {
+		return p.config.JdbcUrl
+	}
 	dataSourcePort := constants.DefaultPostgreSQLPort
 	databaseName := defaultDatabaseName
-	dataSourceURL := postgresql.JdbcUrl
-	if postgresql.ServiceRef != nil {
-		if len(postgresql.ServiceRef.DatabaseSchema) > 0 {
-			databaseSchema = postgresql.ServiceRef.DatabaseSchema
-		}
-		if len(postgresql.ServiceRef.Namespace) > 0 {
-			databaseNamespace = postgresql.ServiceRef.Namespace
-		}
-		if postgresql.ServiceRef.Port != nil {
-			dataSourcePort = *postgresql.ServiceRef.Port
-		}
-		if len(postgresql.ServiceRef.DatabaseName) > 0 {
-			databaseName = postgresql.ServiceRef.DatabaseName
-		}
-		dataSourceURL = fmt.Sprintf("jdbc:postgresql://%s.%s:%d/%s?currentSchema=%s", postgresql.ServiceRef.Name, databaseNamespace, dataSourcePort, databaseName, databaseSchema)
+	if len(p.config.ServiceRef.DatabaseSchema) > 0 {
+		defaultSchema = p.config.ServiceRef.DatabaseSchema
 	}
-	secretRef := corev1.LocalObjectReference{
-		Name: postgresql.SecretRef.Name,
+	if len(p.config.ServiceRef.Namespace) > 0 {
+		namespace = p.config.ServiceRef.Namespace
 	}
-	quarkusDatasourceUsername := "POSTGRESQL_USER"
-	if len(postgresql.SecretRef.UserKey) > 0 {
-		quarkusDatasourceUsername = postgresql.SecretRef.UserKey
+	if p.config.ServiceRef.Port != nil {
+		dataSourcePort = *p.config.ServiceRef.Port
 	}
-	quarkusDatasourcePassword := "POSTGRESQL_PASSWORD"
-	if len(postgresql.SecretRef.PasswordKey) > 0 {
-		quarkusDatasourcePassword = postgresql.SecretRef.PasswordKey
+	if len(p.config.ServiceRef.DatabaseName) > 0 {
+		databaseName = p.config.ServiceRef.DatabaseName
 	}
-	env := []corev1.EnvVar{
-		{
-			Name: "QUARKUS_DATASOURCE_USERNAME",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: &corev1.SecretKeySelector{
-					Key:                  quarkusDatasourceUsername,
-					LocalObjectReference: secretRef,
-				},
-			},
-		},
-		{
-			Name: "QUARKUS_DATASOURCE_PASSWORD",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: &corev1.SecretKeySelector{
-					Key:                  quarkusDatasourcePassword,
-					LocalObjectReference: secretRef,
-				},
-			},
-		},
-		{
-			Name:  "QUARKUS_DATASOURCE_DB_KIND",
-			Value: constants.PersistenceTypePostgreSQL.String(),
-		},
-		{
-			Name:  "QUARKUS_DATASOURCE_JDBC_URL",
-			Value: dataSourceURL,
-		},
+	return fmt.Sprintf("jdbc:postgresql://%s.%s:%d/%s?currentSchema=%s", p.config.ServiceRef.Name, namespace, dataSourcePort, databaseName, defaultSchema)
+}
+
+func (p *postgreSQLProvider) GetReactiveUrl(defaultSchema, namespace string) string {
+	reactiveURL := strings.TrimPrefix(p.GetJdbcUrl(defaultSchema, namespace), "jdbc:")
+	return strings.ReplaceAll(reactiveURL, "currentSchema=", "search_path=")
+}
+
+func (p *postgreSQLProvider) GetSchemaName(defaultSchema string) string {
+	return GetDBSchemaName(p.config, defaultSchema)
+}
+
+func (p *postgreSQLProvider) GetSecretRef() (name, userKey, passwordKey string) {
+	userKey = "POSTGRESQL_USER"
+	if len(p.config.SecretRef.UserKey) > 0 {
+		userKey = p.config.SecretRef.UserKey
 	}
-	if includeReactiveUrl {
-		dataSourceReactiveURL := strings.TrimPrefix(dataSourceURL, "jdbc:")
-		dataSourceReactiveURL = strings.ReplaceAll(dataSourceReactiveURL, "currentSchema=", "search_path=")
-		env = append(env, corev1.EnvVar{
-			Name:  "QUARKUS_DATASOURCE_REACTIVE_URL",
-			Value: dataSourceReactiveURL,
-		})
+	passwordKey = "POSTGRESQL_PASSWORD"
+	if len(p.config.SecretRef.PasswordKey) > 0 {
+		passwordKey = p.config.SecretRef.PasswordKey
 	}
-	env = append(env, corev1.EnvVar{
-		Name:  "KOGITO_PERSISTENCE_TYPE",
-		Value: "jdbc",
-	})
-	return env
+	return p.config.SecretRef.Name, userKey, passwordKey
 }
 
-func ConfigureWorkflowPersistence(serviceContainer *corev1.Container, config *operatorapi.PersistenceOptionsSpec, defaultSchema, namespace string) *corev1.Container {
-	if config.PostgreSQL == nil {
-		return serviceContainer
-	}
-	c := serviceContainer.DeepCopy()
-	c.Env = append(c.Env, ConfigurePostgreSQLEnv(config.PostgreSQL, defaultSchema, namespace, false)...)
-	return c
+func (p *postgreSQLProvider) GetExtensions() []cfg.GroupArtifactId {
+	return GetPostgreSQLExtensions()
 }
 
 func RetrieveConfiguration(primary *v1alpha08.PersistenceOptionsSpec, platformPersistence *v1alpha08.PlatformPersistenceOptionsSpec, schema string) *v1alpha08.PersistenceOptionsSpec {
@@ -154,33 +128,17 @@ func buildPersistenceOptionsSpec(platformPersistence *v1alpha08.PlatformPersiste
 			c.PostgreSQL.JdbcUrl = platformPersistence.PostgreSQL.JdbcUrl
 		}
 	}
+	if platformPersistence.MySQL != nil {
+		c.MySQL = platformPersistence.MySQL.DeepCopy()
+	}
 	return c
 }
 
-func UsesPostgreSQLPersistence(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
-	return (workflow.Spec.Persistence != nil && workflow.Spec.Persistence.PostgreSQL != nil) ||
-		(workflow.Spec.Persistence == nil && platform.Spec.Persistence != nil && platform.Spec.Persistence.PostgreSQL != nil)
-}
-
 // GetPostgreSQLExtensions returns the Quarkus extensions required for postgresql persistence.
 func GetPostgreSQLExtensions() []cfg.GroupArtifactId {
 	return cfg.GetCfg().PostgreSQLPersistenceExtensions
 }
 
-// GetPostgreSQLWorkflowProperties returns the set of application properties required for postgresql persistence.
-// Never nil.
-func GetPostgreSQLWorkflowProperties(workflow *operatorapi.SonataFlow) *properties.Properties {
-	props := properties.NewProperties()
-	if !profiles.IsDevProfile(workflow) && !profiles.IsGitOpsProfile(workflow) {
-		// build-time property required by kogito-runtimes to feed flyway build-time settings and package the necessary .sql files.
-		props.Set(QuarkusDatasourceDBKind, PostgreSQLDBKind)
-		// build-time properties for kogito-runtimes to use jdbc
-		props.Set(KogitoPersistenceType, JDBCPersistenceType)
-		props.Set(KogitoPersistenceProtoMarshaller, "false")
-	}
-	return props
-}
-
 // GetDBSchemaName Parses jdbc url and returns the schema name
 func GetDBSchemaName(persistencePostgreSQL *operatorapi.PersistencePostgreSQL, defaultSchemaName string) string {
 	if persistencePostgreSQL != nil && persistencePostgreSQL.ServiceRef != nil && len(persistencePostgreSQL.ServiceRef.DatabaseSchema) > 0 {
@@ -204,34 +162,3 @@ func GetDBSchemaName(persistencePostgreSQL *operatorapi.PersistencePostgreSQL, d
 	}
 	return defaultSchemaName
 }
-
-func MapToPersistencePostgreSQL(platform *operatorapi.SonataFlowPlatform, defaultSchemaName string) *operatorapi.PersistencePostgreSQL {
-	if platform.Spec.Persistence != nil && platform.Spec.Persistence.PostgreSQL != nil {
-		persistencePostgreSQL := &operatorapi.PersistencePostgreSQL{}
-		persistencePostgreSQL.SecretRef = platform.Spec.Persistence.PostgreSQL.SecretRef
-
-		if len(platform.Spec.Persistence.PostgreSQL.JdbcUrl) > 0 {
-			persistencePostgreSQL.JdbcUrl = platform.Spec.Persistence.PostgreSQL.JdbcUrl
-		}
-
-		serviceRef := &operatorapi.PostgreSQLServiceOptions{}
-		if platform.Spec.Persistence.PostgreSQL.ServiceRef != nil {
-
-			serviceRef.DatabaseSchema = defaultSchemaName
-			serviceRef.SQLServiceOptions = &operatorapi.SQLServiceOptions{}
-
-			if len(platform.Spec.Persistence.PostgreSQL.ServiceRef.Name) > 0 {
-				serviceRef.SQLServiceOptions.Name = platform.Spec.Persistence.PostgreSQL.ServiceRef.Name
-			}
-
-			if len(platform.Spec.Persistence.PostgreSQL.ServiceRef.DatabaseName) > 0 {
-				serviceRef.SQLServiceOptions.DatabaseName = platform.Spec.Persistence.PostgreSQL.ServiceRef.DatabaseName
-			}
-
-			persistencePostgreSQL.ServiceRef = serviceRef
-		}
-		return persistencePostgreSQL
-	}
-
-	return nil
-}
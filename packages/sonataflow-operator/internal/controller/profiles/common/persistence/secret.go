@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package persistence
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+// usedSecrets the persistence Secrets read by the reconcilers, so the Secret watches skip the events of any other Secret.
+var usedSecrets sync.Map
+
+// IsUsedSecret returns true if the given Secret was read as the persistence credentials of a workflow or a platform service.
+func IsUsedSecret(secret client.Object) bool {
+	_, ok := usedSecrets.Load(client.ObjectKeyFromObject(secret))
+	return ok
+}
+
+// GetSecretChecksum returns the checksum of the credentials the given Provider reads from its Secret.
+// Returns an empty string if there's no Provider or the Secret doesn't exist yet, the pods can't start without it anyway.
+func GetSecretChecksum(ctx context.Context, c client.Client, namespace string, provider Provider) (string, error) {
+	if provider == nil {
+		return "", nil
+	}
+	name, userKey, passwordKey := provider.GetSecretRef()
+	usedSecrets.Store(types.NamespacedName{Namespace: namespace, Name: name}, true)
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			klog.V(log.I).InfoS("Persistence secret not found, skipping the checksum", "namespace", namespace, "secret", name)
+			return "", nil
+		}
+		return "", err
+	}
+	return CalculateSecretChecksum(secret, userKey, passwordKey), nil
+}
+
+// CalculateSecretChecksum returns the checksum of the given Secret keys.
+func CalculateSecretChecksum(secret *corev1.Secret, keys ...string) string {
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write(secret.Data[key])
+		hash.Write([]byte(secret.StringData[key]))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SetSecretChecksum sets the persistence secret checksum annotation in the given pod template metadata, so a credentials
+// rotation changes the template and rolls the pods out. The annotation is removed for an empty checksum.
+func SetSecretChecksum(templateMeta *metav1.ObjectMeta, checksum string) {
+	if len(checksum) == 0 {
+		delete(templateMeta.Annotations, metadata.PersistenceSecretChecksum)
+		return
+	}
+	if templateMeta.Annotations == nil {
+		templateMeta.Annotations = make(map[string]string, 1)
+	}
+	templateMeta.Annotations[metadata.PersistenceSecretChecksum] = checksum
+}
+
+// UsesSecret returns true if the given Provider reads its credentials from the named Secret.
+func UsesSecret(provider Provider, secretName string) bool {
+	if provider == nil {
+		return false
+	}
+	name, _, _ := provider.GetSecretRef()
+	return name == secretName
+}
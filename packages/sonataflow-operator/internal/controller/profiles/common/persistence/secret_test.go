@@ -0,0 +1,82 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestGetSecretChecksum(t *testing.T) {
+	provider := NewProvider(&operatorapi.PersistenceOptionsSpec{
+		PostgreSQL: &operatorapi.PersistencePostgreSQL{
+			SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "postgres-secret", UserKey: "user", PasswordKey: "password"},
+			ServiceRef: &operatorapi.PostgreSQLServiceOptions{SQLServiceOptions: &operatorapi.SQLServiceOptions{Name: "postgres"}},
+		},
+	})
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "postgres-secret"},
+		Data:       map[string][]byte{"user": []byte("sonataflow"), "password": []byte("first"), "unrelated": []byte("value")},
+	}
+	cl := test.NewSonataFlowClientBuilder().WithRuntimeObjects(secret).Build()
+
+	assert.False(t, IsUsedSecret(secret))
+	checksum, err := GetSecretChecksum(context.TODO(), cl, "default", provider)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, checksum)
+	assert.True(t, IsUsedSecret(secret))
+
+	secret.Data["unrelated"] = []byte("changed")
+	assert.NoError(t, cl.Update(context.TODO(), secret))
+	unchanged, err := GetSecretChecksum(context.TODO(), cl, "default", provider)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, unchanged)
+
+	secret.Data["password"] = []byte("rotated")
+	assert.NoError(t, cl.Update(context.TODO(), secret))
+	rotated, err := GetSecretChecksum(context.TODO(), cl, "default", provider)
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, rotated)
+
+	missing, err := GetSecretChecksum(context.TODO(), cl, "another", provider)
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+
+	ephemeral, err := GetSecretChecksum(context.TODO(), cl, "default", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, ephemeral)
+}
+
+func TestSetSecretChecksum(t *testing.T) {
+	template := &corev1.PodTemplateSpec{}
+	SetSecretChecksum(&template.ObjectMeta, "abc")
+	assert.Equal(t, "abc", template.Annotations[metadata.PersistenceSecretChecksum])
+
+	SetSecretChecksum(&template.ObjectMeta, "")
+	assert.NotContains(t, template.Annotations, metadata.PersistenceSecretChecksum)
+}
@@ -22,11 +22,11 @@ package properties
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
 	"github.com/magiconair/properties"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
@@ -35,12 +35,9 @@ import (
 
 const (
 	microprofileServiceCatalogPropertyPrefix = "org.kie.kogito.addons.discovery."
-	discoveryLikePropertyPattern             = "^\\${(kubernetes|knative|openshift):(.*)}$"
-	knativeServiceOperationPrefix            = "knative:services.v1.serving.knative.dev"
+	hiddenAddress                            = "*****"
 )
 
-var discoveryLikePropertyExpr = regexp.MustCompile(discoveryLikePropertyPattern)
-
 func removeDiscoveryProperties(props *properties.Properties) {
 	for _, k := range props.Keys() {
 		if strings.HasPrefix(k, microprofileServiceCatalogPropertyPrefix) {
@@ -73,53 +70,73 @@ func escapeValue(unescaped string, value string) string {
 //	org.kie.kogito.addons.discovery.kubernetes\:services.v1\/usecase1\/financial-service?port\=http-port=http://10.5.9.1:8080
 //
 // where http://10.5.9.1:8080 is the corresponding k8s cloud address for the service financial-service in the namespace usecase1.
+// Addresses kept outside the cluster can be read from a configmap or secret entry, e.g. ${config:configmaps.v1/shared/endpoints?key=billing}.
+// The resources pointed by the discovery uris are tracked, so the workflow is reconciled again when any of them changes,
+// and the resolution of every uri is reported in the workflow status.
 func generateDiscoveryProperties(ctx context.Context, catalog discovery.ServiceCatalog, props *properties.Properties,
 	workflow *operatorapi.SonataFlow) *properties.Properties {
 	klog.V(log.I).Infof("Generating service discovery properties for workflow: %s, and namespace: %s.", workflow.Name, workflow.Namespace)
 	result := properties.NewProperties()
+	var uris []discovery.ResourceUri
+	var references []operatorapi.DiscoveryReferenceStatus
 	props.DisableExpansion = true
 	for _, k := range props.Keys() {
 		value, _ := props.Get(k)
 		klog.V(log.I).Infof("Scanning property %s=%s for service discovery configuration.", k, value)
-		if !discoveryLikePropertyExpr.MatchString(value) {
+		reference, ok := discovery.PropertyReference(k, value)
+		if !ok {
 			klog.V(log.I).Infof("Skipping property %s=%s since it does not look like a service discovery configuration.", k, value)
 		} else {
 			klog.V(log.I).Infof("Property %s=%s looks like a service discovery configuration.", k, value)
-			plainUri := value[2 : len(value)-1]
+			plainUri := reference.Uri
+			referenceStatus := operatorapi.DiscoveryReferenceStatus{Source: k, Uri: plainUri}
 			if uri, err := discovery.ParseUri(plainUri); err != nil {
 				klog.V(log.I).Infof("Property %s=%s not correspond to a valid service discovery configuration, it will be excluded from service discovery.", k, value)
+				referenceStatus.Error = err.Error()
 			} else {
 				if len(uri.Namespace) == 0 {
 					klog.V(log.I).Infof("Current service discovery configuration has no configured namespace, workflow namespace: %s will be used instead.", workflow.Namespace)
 					uri.Namespace = workflow.Namespace
 				}
+				uris = append(uris, *uri)
 				if address, err := catalog.Query(ctx, *uri, discovery.KubernetesDNSAddress); err != nil {
 					klog.V(log.E).ErrorS(err, "An error was produced during service address resolution.", "serviceUri", plainUri)
+					referenceStatus.Error = err.Error()
 				} else {
-					klog.V(log.I).Infof("Service: %s was resolved into the following address: %s.", plainUri, address)
+					loggedAddress := address
+					if uri.IsSecret() {
+						loggedAddress = hiddenAddress
+					}
+					klog.V(log.I).Infof("Service: %s was resolved into the following address: %s.", plainUri, loggedAddress)
 					mpProperty := generateMicroprofileServiceCatalogProperty(plainUri)
-					klog.V(log.I).Infof("Generating microprofile service catalog property %s=%s.", mpProperty, address)
+					klog.V(log.I).Infof("Generating microprofile service catalog property %s=%s.", mpProperty, loggedAddress)
 					result.MustSet(mpProperty, address)
-					klog.V(log.I).Infof("Overriding the discoverable value as the managed property %s=%s.", k, address)
+					klog.V(log.I).Infof("Overriding the discoverable value as the managed property %s=%s.", k, loggedAddress)
 					result.MustSet(k, address)
+					referenceStatus.Address = loggedAddress
 				}
 			}
+			references = append(references, referenceStatus)
 		}
 	}
 
 	for _, function := range workflow.Spec.Flow.Functions {
 		klog.V(log.I).Infof("Scanning function: %s for service discovery configuration.", function.Name)
-		if strings.HasPrefix(function.Operation, knativeServiceOperationPrefix) {
+		if reference, ok := discovery.FunctionReference(function); ok {
 			klog.V(log.I).Infof("Function %s looks to be a knative service invocation on service: %s.", function.Name, function.Operation)
-			if uri, err := discovery.ParseUri(function.Operation); err != nil {
+			referenceStatus := operatorapi.DiscoveryReferenceStatus{Source: function.Name, Uri: reference.Uri}
+			if uri, err := discovery.ParseUri(reference.Uri); err != nil {
 				klog.V(log.I).Infof("Operation: %s not correspond to a valid service discovery configuration, it will be excluded from service discovery.", function.Operation)
+				referenceStatus.Error = err.Error()
 			} else {
 				if len(uri.Namespace) == 0 {
 					klog.V(log.I).Infof("Current operation has no configured namespace, workflow namespace: %s will be used instead.", workflow.Namespace)
 					uri.Namespace = workflow.Namespace
 				}
+				uris = append(uris, *uri)
 				if address, err := catalog.Query(ctx, *uri, ""); err != nil {
 					klog.V(log.E).ErrorS(err, "An error was produced during service address resolution.", "serviceUri", function.Operation)
+					referenceStatus.Error = err.Error()
 				} else {
 					// when the knative service is invoked from the workflow as an Operation, the query params are not
 					// used for the microprofile property generation.
@@ -131,9 +148,13 @@ func generateDiscoveryProperties(ctx context.Context, catalog discovery.ServiceC
 					mpProperty := generateMicroprofileServiceCatalogProperty(trimmedUri)
 					klog.V(log.I).Infof("Generating microprofile service catalog property %s=%s.", mpProperty, address)
 					result.MustSet(mpProperty, address)
+					referenceStatus.Address = address
 				}
 			}
+			references = append(references, referenceStatus)
 		}
 	}
+	workflow.Status.DiscoveryReferences = references
+	discovery.TrackWorkflowResources(client.ObjectKeyFromObject(workflow), uris)
 	return result
 }
@@ -26,10 +26,14 @@ import (
 	"github.com/magiconair/properties"
 	"github.com/serverlessworkflow/sdk-go/v2/model"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
 )
 
 func Test_generateDiscoveryProperties(t *testing.T) {
@@ -58,10 +62,11 @@ func Test_generateDiscoveryProperties(t *testing.T) {
 	}
 
 	props := properties.MustLoadString(propertiesContent)
-	result := generateDiscoveryProperties(context.TODO(), catalogService, props, &operatorapi.SonataFlow{
+	sonataFlow := &operatorapi.SonataFlow{
 		ObjectMeta: metav1.ObjectMeta{Name: "helloworld", Namespace: defaultNamespace},
 		Spec:       v1alpha08.SonataFlowSpec{Flow: workflow},
-	})
+	}
+	result := generateDiscoveryProperties(context.TODO(), catalogService, props, sonataFlow)
 
 	assert.Equal(t, 8, result.Len())
 	assertHasProperty(t, result, "service1", myService1Address)
@@ -74,6 +79,23 @@ func Test_generateDiscoveryProperties(t *testing.T) {
 	assertHasProperty(t, result, "org.kie.kogito.addons.discovery.kubernetes\\:services.v1\\/my-service3?port\\=http-port", myService3Address)
 	assertHasProperty(t, result, "org.kie.kogito.addons.discovery.knative\\:services.v1.serving.knative.dev\\/namespace1\\/my-kn-service1", myKnService1Address)
 	assertHasProperty(t, result, "org.kie.kogito.addons.discovery.knative\\:services.v1.serving.knative.dev\\/my-kn-service3", myKnService3Address)
+
+	workflowKey := types.NamespacedName{Name: "helloworld", Namespace: defaultNamespace}
+	assert.Contains(t, discovery.GetWorkflowsResolvedFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-service1", Namespace: "namespace1"}}), workflowKey)
+	assert.Contains(t, discovery.GetWorkflowsResolvedFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-service2", Namespace: defaultNamespace}}), workflowKey)
+	assert.Contains(t, discovery.GetWorkflowsResolvedFrom(&servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-kn-service1", Namespace: "namespace1"}}), workflowKey)
+	assert.NotContains(t, discovery.GetWorkflowsResolvedFrom(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-service1", Namespace: defaultNamespace}}), workflowKey)
+
+	references := sonataFlow.Status.DiscoveryReferences
+	assert.Len(t, references, 6)
+	assert.Contains(t, references, operatorapi.DiscoveryReferenceStatus{Source: "service1", Uri: "kubernetes:services.v1/namespace1/my-service1", Address: myService1Address})
+	assert.Contains(t, references, operatorapi.DiscoveryReferenceStatus{Source: "knServiceInvocation2", Uri: "knative:services.v1.serving.knative.dev/my-kn-service3?path=/knative-function3", Address: myKnService3Address})
+	for _, reference := range references {
+		if reference.Source == "non_service4" {
+			assert.Empty(t, reference.Address)
+			assert.NotEmpty(t, reference.Error)
+		}
+	}
 }
 
 func Test_generateMicroprofileServiceCatalogProperty(t *testing.T) {
@@ -35,6 +35,8 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/kafka"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
 
 	"github.com/magiconair/properties"
 
@@ -116,7 +118,12 @@ func (a *managedPropertyHandler) Build() string {
 
 // withKogitoServiceUrl adds the property kogitoServiceUrlProperty to the application properties.
 // See Service Discovery https://kubernetes.io/docs/concepts/services-networking/service/#dns
+// Workflows serving HTTPS are reached with an https url instead.
 func (a *managedPropertyHandler) withKogitoServiceUrl() ManagedPropertyHandler {
+	if tls.IsServedByWorkflow(a.workflow, a.platform) {
+		return a.addDefaultManagedProperty(constants.KogitoServiceURLProperty,
+			services.GenerateServiceURL(constants.HTTPSProtocol, a.workflow.Namespace, a.workflow.Name))
+	}
 	return a.addDefaultManagedProperty(constants.KogitoServiceURLProperty, GetKogitoServiceUrl(a.workflow))
 }
 
@@ -197,6 +204,11 @@ func NewManagedPropertyHandler(workflow *operatorapi.SonataFlow, platform *opera
 			return nil, err
 		}
 		props.Merge(p)
+		if tls.IsServedByWorkflow(workflow, platform) {
+			props.Merge(tls.GenerateProperties(platform))
+		} else if tls.IsEnabledForWorkflow(workflow, platform) {
+			props.Merge(tls.GenerateClientProperties(platform))
+		}
 	}
 
 	p, err := generateKnativeEventingWorkflowProperties(workflow, platform)
@@ -204,6 +216,7 @@ func NewManagedPropertyHandler(workflow *operatorapi.SonataFlow, platform *opera
 		return nil, err
 	}
 	props.Merge(p)
+	props.Merge(kafka.GenerateProperties(workflow, platform))
 	props.Sort()
 
 	handler.defaultManagedProperties = props
@@ -0,0 +1,333 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/properties"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/schedule"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/tls"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	scheduleRunnerContainerName = "schedule-runner"
+	cronJobKind                 = "CronJob"
+	pingSourceKind              = "PingSource"
+	jsonContentType             = "application/json"
+)
+
+var _ ScheduleHandler = &scheduleManager{}
+
+// ScheduleHandler ensures the resources starting a workflow on its schedules.
+type ScheduleHandler interface {
+	Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error)
+}
+
+type scheduleManager struct {
+	platform *operatorapi.SonataFlowPlatform
+	*StateSupport
+}
+
+func NewScheduleHandler(support *StateSupport, pl *operatorapi.SonataFlowPlatform) ScheduleHandler {
+	return &scheduleManager{
+		platform:     pl,
+		StateSupport: support,
+	}
+}
+
+// Ensure creates or updates a Knative PingSource for every schedule of the workflows deployed with Knative when
+// Knative Eventing is available, or a CronJob calling the workflow endpoint otherwise. The schedules status is
+// reported in the workflow, a schedule that can't be created doesn't prevent the others from being created.
+// The resources of the schedules no longer defined by the workflow are removed.
+func (s *scheduleManager) Ensure(ctx context.Context, workflow *operatorapi.SonataFlow) ([]client.Object, error) {
+	if len(workflow.Spec.Schedules) == 0 && len(workflow.Status.Schedules) == 0 {
+		return nil, nil
+	}
+	knativeAvail, err := knative.GetKnativeAvailability(s.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	usePingSources := workflow.IsKnativeDeployment() && knativeAvail.Eventing
+
+	var objs []client.Object
+	var statuses []operatorapi.ScheduleStatus
+	for i := range workflow.Spec.Schedules {
+		spec := &workflow.Spec.Schedules[i]
+		status := newScheduleStatus(spec)
+		if len(status.Error) == 0 {
+			var obj client.Object
+			if usePingSources {
+				obj = s.ensurePingSource(ctx, workflow, spec, &status)
+			} else {
+				obj = s.ensureCronJob(ctx, workflow, spec, &status)
+			}
+			if obj != nil {
+				objs = append(objs, obj)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	// the PingSources are only looked up when the workflow uses, or used to use, them
+	withPingSources := knativeAvail.Eventing && (usePingSources || hasPingSourceSchedules(workflow))
+	if err := s.deleteUnusedSchedules(ctx, workflow, objs, withPingSources); err != nil {
+		return nil, err
+	}
+	workflow.Status.Schedules = statuses
+	return objs, nil
+}
+
+// newScheduleStatus returns the status of the given schedule with its next run, or the reason why its cron expression
+// can't be evaluated.
+func newScheduleStatus(spec *operatorapi.ScheduleSpec) operatorapi.ScheduleStatus {
+	status := operatorapi.ScheduleStatus{Name: spec.Name}
+	cronSchedule, err := schedule.Parse(spec)
+	if err != nil {
+		status.Error = fmt.Sprintf("invalid schedule: %v", err)
+		return status
+	}
+	if !spec.Suspend {
+		status.NextScheduleTime = &metav1.Time{Time: cronSchedule.Next(time.Now())}
+	}
+	return status
+}
+
+// ensureCronJob creates or updates the CronJob posting the schedule input to the workflow endpoint, and reports its
+// last runs in the given status.
+func (s *scheduleManager) ensureCronJob(ctx context.Context, workflow *operatorapi.SonataFlow, spec *operatorapi.ScheduleSpec, status *operatorapi.ScheduleStatus) client.Object {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: schedule.GetResourceName(workflow, spec), Namespace: workflow.Namespace},
+	}
+	status.Resource = cronJobKind + "/" + cronJob.Name
+	if err := controllerutil.SetControllerReference(workflow, cronJob, s.C.Scheme()); err != nil {
+		status.Error = err.Error()
+		return nil
+	}
+	cronJobSpec := newCronJobSpec(workflow, spec, s.platform)
+	if op, err := controllerutil.CreateOrUpdate(ctx, s.C, cronJob, func() error {
+		cronJob.Labels = workflowproj.GetMergedLabels(workflow)
+		cronJob.Spec = cronJobSpec
+		return nil
+	}); err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to reconcile the CronJob of the workflow schedule", "name", cronJob.Name)
+		status.Error = err.Error()
+		return nil
+	} else {
+		klog.V(log.I).InfoS("CronJob successfully reconciled", "operation", op, "name", cronJob.Name)
+	}
+	status.LastScheduleTime = cronJob.Status.LastScheduleTime
+	status.LastSuccessfulTime = cronJob.Status.LastSuccessfulTime
+	if lastJob, err := s.getLastJob(ctx, workflow, spec); err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to read the last run of the workflow schedule", "name", cronJob.Name)
+	} else if lastJob != nil {
+		for _, condition := range lastJob.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				status.Error = fmt.Sprintf("the last run %s failed: %s", lastJob.Name, condition.Message)
+			}
+		}
+	}
+	return cronJob
+}
+
+// newCronJobSpec returns the spec of the CronJob posting the schedule input to the workflow endpoint. Workflows serving
+// HTTPS are called on their HTTPS port, the runner trusts the platform CA and presents the platform certificate.
+func newCronJobSpec(workflow *operatorapi.SonataFlow, spec *operatorapi.ScheduleSpec, platform *operatorapi.SonataFlowPlatform) batchv1.CronJobSpec {
+	concurrencyPolicy := batchv1.ConcurrencyPolicy(spec.ConcurrencyPolicy)
+	if len(concurrencyPolicy) == 0 {
+		concurrencyPolicy = batchv1.AllowConcurrent
+	}
+	var timeZone *string
+	if len(spec.TimeZone) > 0 {
+		timeZone = ptr.To(spec.TimeZone)
+	}
+	command := []string{"curl", "--fail", "--silent", "--show-error", "--retry", "3",
+		"-X", "POST", "-H", "Content-Type: " + jsonContentType, "-d", schedule.GetInput(spec)}
+	endpointUrl := properties.GetWorkflowEndpointUrl(workflow)
+	if tls.IsServedByWorkflow(workflow, platform) {
+		command = append(command,
+			"--cacert", tls.CertificateMountPath+"/"+tls.CACertKey,
+			"--cert", tls.CertificateMountPath+"/"+corev1.TLSCertKey,
+			"--key", tls.CertificateMountPath+"/"+corev1.TLSPrivateKeyKey)
+		endpointUrl = services.GenerateServiceURL(tls.GetProtocol(platform), workflow.Namespace, workflow.Name) + "/" + workflow.Name
+	}
+	runnerLabels := schedule.GetRunnerLabels(workflow, spec)
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Containers: []corev1.Container{
+			{
+				Name:    scheduleRunnerContainerName,
+				Image:   cfg.GetCfg().ScheduleRunnerImageTag,
+				Command: append(command, endpointUrl),
+			},
+		},
+	}
+	if tls.IsServedByWorkflow(workflow, platform) {
+		tls.MountCertificate(platform, scheduleRunnerContainerName, &podSpec)
+	}
+	return batchv1.CronJobSpec{
+		Schedule:          spec.Cron,
+		TimeZone:          timeZone,
+		ConcurrencyPolicy: concurrencyPolicy,
+		Suspend:           ptr.To(spec.Suspend),
+		JobTemplate: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: ptr.To(int32(2)),
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels},
+					Spec:       podSpec,
+				},
+			},
+		},
+	}
+}
+
+// getLastJob returns the last Job started by the CronJob of the given schedule, nil if it hasn't run yet.
+func (s *scheduleManager) getLastJob(ctx context.Context, workflow *operatorapi.SonataFlow, spec *operatorapi.ScheduleSpec) (*batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	if err := s.C.List(ctx, jobs, client.InNamespace(workflow.Namespace), client.MatchingLabels(schedule.GetRunnerLabels(workflow, spec))); err != nil {
+		return nil, err
+	}
+	var lastJob *batchv1.Job
+	for i := range jobs.Items {
+		if lastJob == nil || jobs.Items[i].CreationTimestamp.After(lastJob.CreationTimestamp.Time) {
+			lastJob = &jobs.Items[i]
+		}
+	}
+	return lastJob, nil
+}
+
+// ensurePingSource creates or updates the PingSource sending the schedule input to the workflow Knative Service.
+// PingSources can't be suspended, a suspended schedule has its PingSource removed instead. PingSources don't track
+// their runs either, so the concurrency policies other than Allow are reported as an error, and no last run is reported.
+func (s *scheduleManager) ensurePingSource(ctx context.Context, workflow *operatorapi.SonataFlow, spec *operatorapi.ScheduleSpec, status *operatorapi.ScheduleStatus) client.Object {
+	if spec.Suspend {
+		return nil
+	}
+	pingSource := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{Name: schedule.GetResourceName(workflow, spec), Namespace: workflow.Namespace},
+	}
+	status.Resource = pingSourceKind + "/" + pingSource.Name
+	if err := controllerutil.SetControllerReference(workflow, pingSource, s.C.Scheme()); err != nil {
+		status.Error = err.Error()
+		return nil
+	}
+	pingSourceSpec := sourcesv1.PingSourceSpec{
+		SourceSpec: duckv1.SourceSpec{
+			Sink: duckv1.Destination{
+				Ref: &duckv1.KReference{
+					Kind:       knativeServiceKind,
+					APIVersion: knativeServingAPIVersion,
+					Name:       workflow.Name,
+					Namespace:  workflow.Namespace,
+				},
+				// relative to the Knative Service address
+				URI: &apis.URL{Path: "/" + workflow.Name},
+			},
+		},
+		Schedule:    spec.Cron,
+		Timezone:    spec.TimeZone,
+		ContentType: jsonContentType,
+		Data:        schedule.GetInput(spec),
+	}
+	if op, err := controllerutil.CreateOrUpdate(ctx, s.C, pingSource, func() error {
+		pingSource.Labels = workflowproj.GetMergedLabels(workflow)
+		pingSource.Spec = pingSourceSpec
+		return nil
+	}); err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to reconcile the PingSource of the workflow schedule", "name", pingSource.Name)
+		status.Error = err.Error()
+		return nil
+	} else {
+		klog.V(log.I).InfoS("PingSource successfully reconciled", "operation", op, "name", pingSource.Name)
+	}
+	if ready := pingSource.Status.GetCondition(apis.ConditionReady); ready != nil && ready.IsFalse() {
+		status.Error = ready.Message
+	} else if len(spec.ConcurrencyPolicy) > 0 && spec.ConcurrencyPolicy != operatorapi.AllowConcurrentSchedule {
+		status.Error = fmt.Sprintf("the %s concurrency policy isn't supported by the PingSource schedules, concurrent runs are allowed", spec.ConcurrencyPolicy)
+	}
+	return pingSource
+}
+
+// hasPingSourceSchedules returns true if the workflow status reports schedules implemented with PingSources.
+func hasPingSourceSchedules(workflow *operatorapi.SonataFlow) bool {
+	for _, status := range workflow.Status.Schedules {
+		if strings.HasPrefix(status.Resource, pingSourceKind+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteUnusedSchedules deletes the CronJobs, and the PingSources if requested, controlled by the workflow other than
+// the given ones.
+func (s *scheduleManager) deleteUnusedSchedules(ctx context.Context, workflow *operatorapi.SonataFlow, objs []client.Object, withPingSources bool) error {
+	keep := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		keep[fmt.Sprintf("%T/%s", obj, obj.GetName())] = true
+	}
+	var unused []client.Object
+	cronJobs := &batchv1.CronJobList{}
+	if err := s.C.List(ctx, cronJobs, client.InNamespace(workflow.Namespace), client.MatchingLabels{workflowproj.LabelWorkflow: workflow.Name}); err != nil {
+		return err
+	}
+	for i := range cronJobs.Items {
+		unused = append(unused, &cronJobs.Items[i])
+	}
+	if withPingSources {
+		pingSources := &sourcesv1.PingSourceList{}
+		if err := s.C.List(ctx, pingSources, client.InNamespace(workflow.Namespace), client.MatchingLabels{workflowproj.LabelWorkflow: workflow.Name}); err != nil {
+			return err
+		}
+		for i := range pingSources.Items {
+			unused = append(unused, &pingSources.Items[i])
+		}
+	}
+	for _, obj := range unused {
+		if keep[fmt.Sprintf("%T/%s", obj, obj.GetName())] || !metav1.IsControlledBy(obj, workflow) {
+			continue
+		}
+		klog.V(log.I).InfoS("Removing the schedule since it's no longer defined by the workflow", "name", obj.GetName())
+		if err := s.C.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package schedule holds the helpers shared by the controller implementing the workflow schedules and the webhook
+// validating them.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+const (
+	// LabelScheduleWorkflow identifies the workflow started by the schedule runner pods.
+	// The runner pods can't carry the workflow labels, otherwise they would be selected by the workflow Service.
+	LabelScheduleWorkflow = "sonataflow.org/schedule-workflow"
+	// LabelSchedule identifies the schedule of the runner pods.
+	LabelSchedule = "sonataflow.org/schedule"
+	// MaxResourceNameLength is the maximum length of the name of the resources implementing a schedule, the CronJob
+	// controller appends an 11 characters suffix to the 63 characters long Job names.
+	MaxResourceNameLength = 52
+	// DefaultInput is the input the workflow instances are started with when the schedule defines none.
+	DefaultInput = "{}"
+)
+
+// GetResourceName returns the name of the CronJob or PingSource implementing the given schedule.
+func GetResourceName(workflow *operatorapi.SonataFlow, schedule *operatorapi.ScheduleSpec) string {
+	return fmt.Sprintf("%s-%s", workflow.Name, schedule.Name)
+}
+
+// GetInput returns the JSON document the workflow instances are started with.
+func GetInput(schedule *operatorapi.ScheduleSpec) string {
+	if len(strings.TrimSpace(schedule.Input)) == 0 {
+		return DefaultInput
+	}
+	return schedule.Input
+}
+
+// GetRunnerLabels returns the labels of the pods started by the CronJob implementing the given schedule.
+func GetRunnerLabels(workflow *operatorapi.SonataFlow, schedule *operatorapi.ScheduleSpec) map[string]string {
+	return map[string]string{
+		LabelScheduleWorkflow: workflow.Name,
+		LabelSchedule:         schedule.Name,
+	}
+}
+
+// Parse parses the cron expression of the given schedule in its time zone.
+func Parse(schedule *operatorapi.ScheduleSpec) (cron.Schedule, error) {
+	if strings.Contains(schedule.Cron, "TZ=") {
+		return nil, fmt.Errorf("the time zone must be set with the timeZone field instead of the cron expression")
+	}
+	if len(schedule.TimeZone) > 0 {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %s: %w", schedule.TimeZone, err)
+		}
+		return cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", schedule.TimeZone, schedule.Cron))
+	}
+	return cron.ParseStandard(schedule.Cron)
+}
@@ -0,0 +1,146 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/schedule"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
+)
+
+func TestScheduleHandler_CronJob(t *testing.T) {
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Schedules = []v1alpha08.ScheduleSpec{
+		{Name: "nightly", Cron: "0 2 * * *", TimeZone: "Europe/Madrid", Input: `{"name": "Ada"}`, ConcurrencyPolicy: v1alpha08.ForbidConcurrentSchedule},
+		{Name: "paused", Cron: "*/5 * * * *", Suspend: true},
+	}
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: workflow.Name + "-nightly-28000000", Namespace: t.Name(),
+			Labels: schedule.GetRunnerLabels(workflow, &workflow.Spec.Schedules[0])},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+		}},
+	}
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, failedJob).Build()
+
+	objs, err := NewScheduleHandler(&StateSupport{C: cli}, nil).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+
+	cronJob := objs[0].(*batchv1.CronJob)
+	assert.Equal(t, workflow.Name+"-nightly", cronJob.Name)
+	assert.Equal(t, "0 2 * * *", cronJob.Spec.Schedule)
+	assert.Equal(t, "Europe/Madrid", *cronJob.Spec.TimeZone)
+	assert.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	assert.False(t, *cronJob.Spec.Suspend)
+	assert.Equal(t, schedule.GetRunnerLabels(workflow, &workflow.Spec.Schedules[0]), cronJob.Spec.JobTemplate.Spec.Template.Labels)
+	command := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Command
+	assert.Contains(t, command, `{"name": "Ada"}`)
+	assert.Equal(t, "http://"+workflow.Name+"."+t.Name()+"/"+workflow.Name, command[len(command)-1])
+	assert.Equal(t, batchv1.AllowConcurrent, objs[1].(*batchv1.CronJob).Spec.ConcurrencyPolicy)
+	assert.Contains(t, objs[1].(*batchv1.CronJob).Spec.JobTemplate.Spec.Template.Spec.Containers[0].Command, schedule.DefaultInput)
+
+	assert.Len(t, workflow.Status.Schedules, 2)
+	assert.Equal(t, "CronJob/"+workflow.Name+"-nightly", workflow.Status.Schedules[0].Resource)
+	assert.NotNil(t, workflow.Status.Schedules[0].NextScheduleTime)
+	assert.Contains(t, workflow.Status.Schedules[0].Error, "backoff limit")
+	assert.Nil(t, workflow.Status.Schedules[1].NextScheduleTime)
+	assert.Empty(t, workflow.Status.Schedules[1].Error)
+
+	workflow.Spec.Schedules = workflow.Spec.Schedules[:1]
+	objs, err = NewScheduleHandler(&StateSupport{C: cli}, nil).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	cronJobs := &batchv1.CronJobList{}
+	assert.NoError(t, cli.List(context.TODO(), cronJobs, client.InNamespace(t.Name())))
+	assert.Len(t, cronJobs.Items, 1)
+	assert.Len(t, workflow.Status.Schedules, 1)
+}
+
+func TestScheduleHandler_CronJobWithTLS(t *testing.T) {
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Schedules = []v1alpha08.ScheduleSpec{{Name: "nightly", Cron: "0 2 * * *"}}
+	platform := test.GetBasePlatformInReadyPhase(t.Name())
+	platform.Spec.TLS = &v1alpha08.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls", ClientAuth: v1alpha08.TLSClientAuthRequest}
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow).Build()
+
+	objs, err := NewScheduleHandler(&StateSupport{C: cli}, platform).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	podSpec := objs[0].(*batchv1.CronJob).Spec.JobTemplate.Spec.Template.Spec
+	command := podSpec.Containers[0].Command
+	assert.Equal(t, "https://"+workflow.Name+"."+t.Name()+"/"+workflow.Name, command[len(command)-1])
+	assert.Contains(t, strings.Join(command, " "), "--cacert /etc/sonataflow/tls/ca.crt --cert /etc/sonataflow/tls/tls.crt --key /etc/sonataflow/tls/tls.key")
+	assert.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "platform-tls", podSpec.Volumes[0].Secret.SecretName)
+	assert.Len(t, podSpec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, "/etc/sonataflow/tls", podSpec.Containers[0].VolumeMounts[0].MountPath)
+}
+
+func TestScheduleHandler_PingSource(t *testing.T) {
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.Schedules = []v1alpha08.ScheduleSpec{{Name: "hourly", Cron: "0 * * * *", TimeZone: "UTC"}}
+	cli := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow).Build()
+
+	objs, err := NewScheduleHandler(&StateSupport{C: cli}, nil).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	pingSource := objs[0].(*sourcesv1.PingSource)
+	assert.Equal(t, workflow.Name+"-hourly", pingSource.Name)
+	assert.Equal(t, "0 * * * *", pingSource.Spec.Schedule)
+	assert.Equal(t, "UTC", pingSource.Spec.Timezone)
+	assert.Equal(t, schedule.DefaultInput, pingSource.Spec.Data)
+	assert.Equal(t, workflow.Name, pingSource.Spec.Sink.Ref.Name)
+	assert.Equal(t, "/"+workflow.Name, pingSource.Spec.Sink.URI.Path)
+	assert.Equal(t, "PingSource/"+workflow.Name+"-hourly", workflow.Status.Schedules[0].Resource)
+	assert.Empty(t, workflow.Status.Schedules[0].Error)
+
+	workflow.Spec.Schedules[0].ConcurrencyPolicy = v1alpha08.ForbidConcurrentSchedule
+	objs, err = NewScheduleHandler(&StateSupport{C: cli}, nil).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	assert.Contains(t, workflow.Status.Schedules[0].Error, "Forbid concurrency policy")
+
+	workflow.Spec.Schedules[0].Suspend = true
+	objs, err = NewScheduleHandler(&StateSupport{C: cli}, nil).Ensure(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Empty(t, objs)
+	pingSources := &sourcesv1.PingSourceList{}
+	assert.NoError(t, cli.List(context.TODO(), pingSources, client.InNamespace(t.Name())))
+	assert.Empty(t, pingSources.Items)
+}
@@ -0,0 +1,214 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tls configures the HTTPS endpoints of the workflows and the supporting services deployed in a platform
+// namespace with TLS enabled.
+package tls
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
+)
+
+const (
+	// HTTPSPortName is the name of the HTTPS container and service ports.
+	HTTPSPortName = "https"
+	// HTTPSContainerPort is the port Quarkus listens to HTTPS requests on.
+	HTTPSContainerPort = 8443
+	// HTTPSServicePort is the HTTPS port exposed by the Kubernetes services.
+	HTTPSServicePort = 443
+	// ManagementPortName is the name of the container port serving the health probes when the plain HTTP port is disabled.
+	ManagementPortName = "management"
+	// ManagementContainerPort is the port of the Quarkus management interface.
+	ManagementContainerPort = 9000
+	// CertificateMountPath is the directory the certificate Secret is mounted at.
+	CertificateMountPath = "/etc/sonataflow/tls"
+	// CACertKey is the certificate Secret entry holding the platform CA.
+	CACertKey             = "ca.crt"
+	certificateVolumeName = "tls-certificate"
+	certificateSecretName = "%s-tls"
+)
+
+// IsEnabled returns true if the given platform secures its namespace with HTTPS.
+func IsEnabled(platform *operatorapi.SonataFlowPlatform) bool {
+	return platform != nil && platform.Spec.TLS != nil && platform.Spec.TLS.Enabled
+}
+
+// IsEnabledForWorkflow returns true if the given workflow must be configured with the platform certificate.
+// Dev profile workflows are never configured, and the certificate Secret can only be mounted in the platform namespace.
+func IsEnabledForWorkflow(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return IsEnabled(platform) && !profiles.IsDevProfile(workflow) && workflow.Namespace == platform.Namespace
+}
+
+// IsServedByWorkflow returns true if the given workflow serves HTTPS itself. Workflows deployed with Knative are
+// secured by Knative Serving instead, they only use the certificate for their outgoing requests.
+func IsServedByWorkflow(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return IsEnabledForWorkflow(workflow, platform) && !workflow.IsKnativeDeployment()
+}
+
+// IsClientAuthRequired returns true if the platform rejects the clients without a certificate, the plain HTTP port
+// is then disabled and the health probes are served by the management interface.
+// The admission webhook refuses such platforms, since some consumers still use the plain HTTP port.
+func IsClientAuthRequired(platform *operatorapi.SonataFlowPlatform) bool {
+	return IsEnabled(platform) && platform.Spec.TLS.ClientAuth == operatorapi.TLSClientAuthRequired
+}
+
+// IsCertManagerEnabled returns true if the platform certificate is requested to cert-manager.
+func IsCertManagerEnabled(platform *operatorapi.SonataFlowPlatform) bool {
+	return IsEnabled(platform) && platform.Spec.TLS.CertManager != nil
+}
+
+// GetSecretName returns the name of the Secret holding the platform certificate, the one referenced by the user or
+// the one written by cert-manager.
+func GetSecretName(platform *operatorapi.SonataFlowPlatform) string {
+	if len(platform.Spec.TLS.SecretRef) > 0 {
+		return platform.Spec.TLS.SecretRef
+	}
+	return fmt.Sprintf(certificateSecretName, platform.Name)
+}
+
+// GetProtocol returns the protocol of the service URLs generated for the given platform. Only the consumers in the
+// platform namespace mount the platform CA, the other ones use the plain HTTP port.
+func GetProtocol(platform *operatorapi.SonataFlowPlatform) string {
+	if IsEnabled(platform) {
+		return constants.HTTPSProtocol
+	}
+	return constants.DefaultHTTPProtocol
+}
+
+// GenerateProperties returns the Quarkus properties that open the HTTPS port with the platform certificate, and make
+// the outgoing requests trust the platform CA and present the platform certificate.
+// The plain HTTP port is kept for the health probes and the remote consumers, unless the client certificate is
+// required, the probes are then moved to the management interface.
+func GenerateProperties(platform *operatorapi.SonataFlowPlatform) *properties.Properties {
+	props := GenerateClientProperties(platform)
+	if !IsEnabled(platform) {
+		return props
+	}
+	props.Set(constants.QuarkusHTTPSSLPort, strconv.Itoa(HTTPSContainerPort))
+	if IsClientAuthRequired(platform) {
+		props.Set(constants.QuarkusHTTPInsecureRequests, "disabled")
+		props.Set(constants.QuarkusManagementEnabled, "true")
+		props.Set(constants.QuarkusManagementPort, strconv.Itoa(ManagementContainerPort))
+	} else {
+		props.Set(constants.QuarkusHTTPInsecureRequests, "enabled")
+	}
+	if clientAuth := platform.Spec.TLS.ClientAuth; len(clientAuth) > 0 {
+		props.Set(constants.QuarkusHTTPSSLClientAuth, strings.ToUpper(string(clientAuth)))
+	}
+	return props
+}
+
+// GenerateClientProperties returns the Quarkus properties that make the outgoing requests trust the platform CA and
+// present the platform certificate, without opening the HTTPS port. Used by the Knative workflows, which are served
+// by Knative Serving.
+func GenerateClientProperties(platform *operatorapi.SonataFlowPlatform) *properties.Properties {
+	props := properties.NewProperties()
+	if !IsEnabled(platform) {
+		return props
+	}
+	props.Set(constants.QuarkusTLSKeyStorePEMCert, CertificateMountPath+"/"+corev1.TLSCertKey)
+	props.Set(constants.QuarkusTLSKeyStorePEMKey, CertificateMountPath+"/"+corev1.TLSPrivateKeyKey)
+	props.Set(constants.QuarkusTLSTrustStorePEMCerts, CertificateMountPath+"/"+CACertKey)
+	props.Set(constants.QuarkusTLSReloadPeriod, constants.DefaultQuarkusTLSReloadPeriod)
+	return props
+}
+
+// ConfigurePodSpec mounts the platform certificate in the given container, and declares its HTTPS port.
+// When the client certificate is required, the probes targeting the disabled HTTP port are moved to the management port.
+func ConfigurePodSpec(platform *operatorapi.SonataFlowPlatform, containerName string, podSpec *corev1.PodSpec) {
+	if !IsEnabled(platform) {
+		return
+	}
+	MountCertificate(platform, containerName, podSpec)
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name != containerName {
+			continue
+		}
+		container := &podSpec.Containers[i]
+		addOrReplaceContainerPort(container, corev1.ContainerPort{Name: HTTPSPortName, ContainerPort: HTTPSContainerPort, Protocol: corev1.ProtocolTCP})
+		if IsClientAuthRequired(platform) {
+			addOrReplaceContainerPort(container, corev1.ContainerPort{Name: ManagementPortName, ContainerPort: ManagementContainerPort, Protocol: corev1.ProtocolTCP})
+			for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+				if probe != nil && probe.HTTPGet != nil && probe.HTTPGet.Port.IntValue() == constants.DefaultHTTPWorkflowPortInt {
+					probe.HTTPGet.Port = intstr.FromInt32(ManagementContainerPort)
+				}
+			}
+		}
+	}
+}
+
+// MountCertificate mounts the platform certificate in the given container, without declaring the HTTPS port.
+// Used by the Knative deployments, that can only declare the serving port.
+func MountCertificate(platform *operatorapi.SonataFlowPlatform, containerName string, podSpec *corev1.PodSpec) {
+	if !IsEnabled(platform) {
+		return
+	}
+	kubeutil.AddOrReplaceVolume(podSpec, corev1.Volume{
+		Name: certificateVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: GetSecretName(platform)},
+		},
+	})
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == containerName {
+			kubeutil.AddOrReplaceVolumeMount(&podSpec.Containers[i], corev1.VolumeMount{Name: certificateVolumeName, MountPath: CertificateMountPath, ReadOnly: true})
+		}
+	}
+}
+
+// ConfigureServiceSpec adds the HTTPS port to the given service, next to the plain HTTP one.
+func ConfigureServiceSpec(platform *operatorapi.SonataFlowPlatform, serviceSpec *corev1.ServiceSpec) {
+	if !IsEnabled(platform) {
+		return
+	}
+	port := corev1.ServicePort{
+		Name:       HTTPSPortName,
+		Protocol:   corev1.ProtocolTCP,
+		Port:       HTTPSServicePort,
+		TargetPort: intstr.FromInt32(HTTPSContainerPort),
+	}
+	for i := range serviceSpec.Ports {
+		if serviceSpec.Ports[i].Name == HTTPSPortName {
+			serviceSpec.Ports[i] = port
+			return
+		}
+	}
+	serviceSpec.Ports = append(serviceSpec.Ports, port)
+}
+
+func addOrReplaceContainerPort(container *corev1.Container, port corev1.ContainerPort) {
+	for i := range container.Ports {
+		if container.Ports[i].Name == port.Name {
+			container.Ports[i] = port
+			return
+		}
+	}
+	container.Ports = append(container.Ports, port)
+}
@@ -0,0 +1,149 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+)
+
+func newPlatform(tls *operatorapi.PlatformTLSSpec) *operatorapi.SonataFlowPlatform {
+	return &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonataflow-platform", Namespace: "default"},
+		Spec:       operatorapi.SonataFlowPlatformSpec{TLS: tls},
+	}
+}
+
+func TestIsEnabledForWorkflow(t *testing.T) {
+	platform := newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls"})
+	workflow := &operatorapi.SonataFlow{ObjectMeta: metav1.ObjectMeta{Name: "greeting", Namespace: "default",
+		Annotations: map[string]string{metadata.Profile: metadata.PreviewProfile.String()}}}
+	assert.True(t, IsEnabledForWorkflow(workflow, platform))
+	assert.True(t, IsServedByWorkflow(workflow, platform))
+
+	workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+	assert.True(t, IsEnabledForWorkflow(workflow, platform))
+	assert.False(t, IsServedByWorkflow(workflow, platform))
+
+	workflow.Annotations[metadata.Profile] = metadata.DevProfile.String()
+	assert.False(t, IsEnabledForWorkflow(workflow, platform))
+
+	workflow.Annotations[metadata.Profile] = metadata.PreviewProfile.String()
+	workflow.Namespace = "other"
+	assert.False(t, IsEnabledForWorkflow(workflow, platform))
+
+	assert.False(t, IsEnabledForWorkflow(workflow, newPlatform(&operatorapi.PlatformTLSSpec{SecretRef: "platform-tls"})))
+	assert.False(t, IsEnabledForWorkflow(workflow, nil))
+}
+
+func TestGetSecretName(t *testing.T) {
+	assert.Equal(t, "platform-tls", GetSecretName(newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls"})))
+	assert.Equal(t, "sonataflow-platform-tls", GetSecretName(newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true,
+		CertManager: &operatorapi.CertManagerTLSSpec{IssuerRef: operatorapi.CertManagerIssuerReference{Name: "ca-issuer"}}})))
+}
+
+func TestGenerateProperties(t *testing.T) {
+	assert.Equal(t, 0, GenerateProperties(newPlatform(nil)).Len())
+	assert.Equal(t, constants.DefaultHTTPProtocol, GetProtocol(newPlatform(nil)))
+
+	platform := newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls", ClientAuth: operatorapi.TLSClientAuthRequired})
+	assert.Equal(t, constants.HTTPSProtocol, GetProtocol(platform))
+	props := GenerateProperties(platform)
+	assert.Equal(t, "8443", props.GetString(constants.QuarkusHTTPSSLPort, ""))
+	assert.Equal(t, "/etc/sonataflow/tls/tls.crt", props.GetString(constants.QuarkusTLSKeyStorePEMCert, ""))
+	assert.Equal(t, "/etc/sonataflow/tls/tls.key", props.GetString(constants.QuarkusTLSKeyStorePEMKey, ""))
+	assert.Equal(t, "/etc/sonataflow/tls/ca.crt", props.GetString(constants.QuarkusTLSTrustStorePEMCerts, ""))
+	assert.Equal(t, "REQUIRED", props.GetString(constants.QuarkusHTTPSSLClientAuth, ""))
+	assert.Equal(t, "disabled", props.GetString(constants.QuarkusHTTPInsecureRequests, ""))
+	assert.Equal(t, "9000", props.GetString(constants.QuarkusManagementPort, ""))
+
+	platform.Spec.TLS.ClientAuth = ""
+	props = GenerateProperties(platform)
+	_, ok := props.Get(constants.QuarkusHTTPSSLClientAuth)
+	assert.False(t, ok)
+	assert.Equal(t, "enabled", props.GetString(constants.QuarkusHTTPInsecureRequests, ""))
+	_, ok = props.Get(constants.QuarkusManagementEnabled)
+	assert.False(t, ok)
+
+	clientProps := GenerateClientProperties(platform)
+	assert.Equal(t, "/etc/sonataflow/tls/ca.crt", clientProps.GetString(constants.QuarkusTLSTrustStorePEMCerts, ""))
+	_, ok = clientProps.Get(constants.QuarkusHTTPSSLPort)
+	assert.False(t, ok)
+}
+
+func TestConfigurePodSpec(t *testing.T) {
+	platform := newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls"})
+	podSpec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}, {Name: "sidecar"}}}
+
+	ConfigurePodSpec(platform, "main", podSpec)
+	ConfigurePodSpec(platform, "main", podSpec)
+	assert.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "platform-tls", podSpec.Volumes[0].Secret.SecretName)
+	assert.Len(t, podSpec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, CertificateMountPath, podSpec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, []corev1.ContainerPort{{Name: HTTPSPortName, ContainerPort: HTTPSContainerPort, Protocol: corev1.ProtocolTCP}}, podSpec.Containers[0].Ports)
+	assert.Empty(t, podSpec.Containers[1].VolumeMounts)
+
+	knativePodSpec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}}
+	MountCertificate(platform, "main", knativePodSpec)
+	assert.Len(t, knativePodSpec.Containers[0].VolumeMounts, 1)
+	assert.Empty(t, knativePodSpec.Containers[0].Ports)
+
+	disabledPodSpec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}}
+	ConfigurePodSpec(newPlatform(nil), "main", disabledPodSpec)
+	assert.Empty(t, disabledPodSpec.Volumes)
+}
+
+func TestConfigurePodSpecWithRequiredClientAuth(t *testing.T) {
+	platform := newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls", ClientAuth: operatorapi.TLSClientAuthRequired})
+	probe := func(port intstr.IntOrString) *corev1.Probe {
+		return &corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: constants.QuarkusHealthPathReady, Port: port}}}
+	}
+	podSpec := &corev1.PodSpec{Containers: []corev1.Container{{
+		Name:           "main",
+		ReadinessProbe: probe(intstr.FromInt32(constants.DefaultHTTPWorkflowPortInt)),
+		LivenessProbe:  probe(intstr.FromInt32(8081)),
+	}}}
+
+	ConfigurePodSpec(platform, "main", podSpec)
+	container := podSpec.Containers[0]
+	assert.Contains(t, container.Ports, corev1.ContainerPort{Name: ManagementPortName, ContainerPort: ManagementContainerPort, Protocol: corev1.ProtocolTCP})
+	assert.Equal(t, ManagementContainerPort, container.ReadinessProbe.HTTPGet.Port.IntValue())
+	assert.Equal(t, 8081, container.LivenessProbe.HTTPGet.Port.IntValue())
+}
+
+func TestConfigureServiceSpec(t *testing.T) {
+	platform := newPlatform(&operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls"})
+	serviceSpec := &corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "web", Port: 80}}}
+
+	ConfigureServiceSpec(platform, serviceSpec)
+	ConfigureServiceSpec(platform, serviceSpec)
+	assert.Len(t, serviceSpec.Ports, 2)
+	assert.Equal(t, int32(HTTPSServicePort), serviceSpec.Ports[1].Port)
+	assert.Equal(t, int32(HTTPSContainerPort), serviceSpec.Ports[1].TargetPort.IntVal)
+}
@@ -36,11 +36,16 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/properties"
 
 	"k8s.io/klog/v2"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/workflowdef"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -52,6 +57,7 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/monitoring"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
 )
 
@@ -89,6 +95,9 @@ func (d *DeploymentReconciler) reconcileWithImage(ctx context.Context, workflow
 	if err != nil {
 		return reconcile.Result{Requeue: false}, nil, err
 	}
+	if workflow.Status.Rollout.IsInProgress() && result.RequeueAfter > constants.RequeueAfterFollowDeployment {
+		result.RequeueAfter = constants.RequeueAfterFollowDeployment
+	}
 
 	d.updateLastTimeStatusNotified(workflow, previousStatus)
 	if _, err := d.PerformStatusUpdate(ctx, workflow); err != nil {
@@ -132,16 +141,25 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 		return reconcile.Result{}, nil, err
 	}
 
-	deployment, deploymentOp, err :=
-		d.ensurers.DeploymentByDeploymentModel(workflow).Ensure(ctx, workflow, pl,
-			d.deploymentModelMutateVisitors(workflow, pl, image, userPropsCM.(*v1.ConfigMap), managedPropsCM.(*v1.ConfigMap))...)
+	secretChecksum, err := persistence.GetSecretChecksum(ctx, d.C, workflow.Namespace, persistence.ResolveWorkflowProvider(workflow, pl))
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+
+	rollout := newRolloutHandler(d.StateSupport)
+	if err = rollout.cleanup(ctx, workflow); err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	deployment, deploymentOp, err := d.ensureDeployment(ctx, workflow, pl, rollout,
+		append(d.deploymentModelMutateVisitors(workflow, pl, image, userPropsCM.(*v1.ConfigMap), managedPropsCM.(*v1.ConfigMap)),
+			common.PersistenceSecretChecksumMutateVisitor(secretChecksum)))
 	if err != nil {
 		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to perform the deploy due to ", err)
 		_, _ = d.PerformStatusUpdate(ctx, workflow)
 		return reconcile.Result{}, nil, err
 	}
 
-	service, _, err := d.ensurers.ServiceByDeploymentModel(workflow).Ensure(ctx, workflow, common.ServiceMutateVisitor(workflow))
+	service, _, err := d.ensurers.ServiceByDeploymentModel(workflow).Ensure(ctx, workflow, common.ServiceMutateVisitor(workflow), common.TLSMutateVisitor(workflow, pl), rollout.serviceMutateVisitor(workflow))
 	if err != nil {
 		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to make the service available due to ", err)
 		_, _ = d.PerformStatusUpdate(ctx, workflow)
@@ -149,12 +167,56 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 	}
 
 	objs := []client.Object{deployment, managedPropsCM, service}
+	exposure, err := d.ensureExposure(ctx, workflow, pl)
+	if err != nil {
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to expose the workflow due to ", err)
+		_, _ = d.PerformStatusUpdate(ctx, workflow)
+		return reconcile.Result{}, nil, err
+	}
+	if exposure != nil {
+		objs = append(objs, exposure)
+	}
+
 	eventingObjs, err := common.NewKnativeEventingHandler(d.StateSupport, pl).Ensure(ctx, workflow)
 	if err != nil {
 		return reconcile.Result{}, nil, err
 	}
 	objs = append(objs, eventingObjs...)
 
+	kafkaObjs, err := common.NewKafkaEventingHandler(d.StateSupport, pl).Ensure(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	objs = append(objs, kafkaObjs...)
+
+	networkPolicies, err := common.NewNetworkPolicyHandler(d.StateSupport, pl).Ensure(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	objs = append(objs, networkPolicies...)
+
+	schedules, err := common.NewScheduleHandler(d.StateSupport, pl).Ensure(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	objs = append(objs, schedules...)
+
+	hpa, err := d.ensureHorizontalPodAutoscaler(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if hpa != nil {
+		objs = append(objs, hpa)
+	}
+
+	pdb, err := d.ensurePodDisruptionBudget(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if pdb != nil {
+		objs = append(objs, pdb)
+	}
+
 	serviceMonitor, err := d.ensureServiceMonitor(ctx, workflow, pl)
 	if err != nil {
 		return reconcile.Result{}, nil, err
@@ -173,6 +235,103 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 	return reconcile.Result{}, objs, nil
 }
 
+// ensureDeployment ensures the workflow Deployment or Knative Service, honoring the rollout strategy when configured.
+func (d *DeploymentReconciler) ensureDeployment(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform,
+	rollout *rolloutHandler, visitors []common.MutateVisitor) (client.Object, controllerutil.OperationResult, error) {
+	ensurer := d.ensurers.DeploymentByDeploymentModel(workflow)
+	if !isRolloutEnabled(workflow) {
+		return ensurer.Ensure(ctx, workflow, pl, visitors...)
+	}
+	if !workflow.IsKnativeDeployment() {
+		return rollout.ensureBlueGreenDeployment(ctx, workflow, pl, ensurer, visitors)
+	}
+	deployment, deploymentOp, err := ensurer.Ensure(ctx, workflow, pl, visitors...)
+	if err != nil || deploymentOp == controllerutil.OperationResultCreated {
+		return deployment, deploymentOp, err
+	}
+	return deployment, deploymentOp, rollout.followKService(ctx, workflow, deployment.(*servingv1.Service))
+}
+
+// ensureHorizontalPodAutoscaler ensures the workflow HorizontalPodAutoscaler, or removes it once the autoscaling is disabled.
+func (d *DeploymentReconciler) ensureHorizontalPodAutoscaler(ctx context.Context, workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.PodTemplate.Autoscaling == nil || workflow.IsKnativeDeployment() {
+		return nil, d.deleteControlledObject(ctx, workflow, &autoscalingv2.HorizontalPodAutoscaler{})
+	}
+	hpa, _, err := d.ensurers.HorizontalPodAutoscalerByDeploymentModel(workflow).Ensure(ctx, workflow, common.HorizontalPodAutoscalerMutateVisitor(workflow))
+	return hpa, err
+}
+
+// ensurePodDisruptionBudget ensures the workflow PodDisruptionBudget, or removes it once the disruption budget is disabled.
+func (d *DeploymentReconciler) ensurePodDisruptionBudget(ctx context.Context, workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.PodTemplate.DisruptionBudget == nil || workflow.IsKnativeDeployment() {
+		return nil, d.deleteControlledObject(ctx, workflow, &policyv1.PodDisruptionBudget{})
+	}
+	pdb, _, err := d.ensurers.PodDisruptionBudgetByDeploymentModel(workflow).Ensure(ctx, workflow, common.PodDisruptionBudgetMutateVisitor(workflow))
+	return pdb, err
+}
+
+// ensureExposure ensures the Ingress or HTTPRoute exposing the workflow and reports its external URL in the status endpoint.
+// The exposure resources are removed once the workflow is no longer exposed, or exposed with the other resource type.
+func (d *DeploymentReconciler) ensureExposure(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	exposure := common.ResolveExposure(workflow, pl)
+	var exposureType operatorapi.ExposureType
+	var invalid error
+	if exposure != nil {
+		// an invalid exposure removes the previous one, rather than reporting an endpoint nothing routes to
+		if invalid = common.ValidateExposure(exposure); invalid == nil {
+			exposureType = exposure.Type
+		}
+	}
+	if err := d.deleteExposure(ctx, workflow, exposureType); err != nil {
+		return nil, err
+	}
+	if exposure == nil || invalid != nil {
+		workflow.Status.Endpoint = nil
+		return nil, invalid
+	}
+
+	var object client.Object
+	var err error
+	if exposure.Type == operatorapi.HTTPRouteExposureType {
+		object, _, err = d.ensurers.httpRoute.Ensure(ctx, workflow, pl, common.HTTPRouteMutateVisitor(workflow, pl))
+	} else {
+		object, _, err = d.ensurers.ingress.Ensure(ctx, workflow, pl, common.IngressMutateVisitor(workflow, pl))
+	}
+	if err != nil {
+		return nil, err
+	}
+	workflow.Status.Endpoint = common.GetExposureEndpoint(exposure, object)
+	return object, nil
+}
+
+// deleteExposure deletes the workflow exposure resources other than the given type.
+func (d *DeploymentReconciler) deleteExposure(ctx context.Context, workflow *operatorapi.SonataFlow, keep operatorapi.ExposureType) error {
+	if keep != operatorapi.IngressExposureType {
+		if err := d.deleteControlledObject(ctx, workflow, &networkingv1.Ingress{}); err != nil {
+			return err
+		}
+	}
+	// HTTPRoutes aren't cached, so they're only looked up if the workflow was exposed before
+	if keep != operatorapi.HTTPRouteExposureType && workflow.Status.Endpoint != nil {
+		if err := d.deleteControlledObject(ctx, workflow, common.NewHTTPRoute(workflow.Namespace, workflow.Name)); err != nil && !meta.IsNoMatchError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteControlledObject deletes the object named after the workflow if it's controlled by it.
+func (d *DeploymentReconciler) deleteControlledObject(ctx context.Context, workflow *operatorapi.SonataFlow, object client.Object) error {
+	if err := d.C.Get(ctx, client.ObjectKeyFromObject(workflow), object); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if metav1.IsControlledBy(object, workflow) {
+		klog.V(log.I).InfoS("Removing the workflow object since it's no longer configured", "type", fmt.Sprintf("%T", object))
+		return client.IgnoreNotFound(d.C.Delete(ctx, object))
+	}
+	return nil
+}
+
 func (d *DeploymentReconciler) ensureServiceMonitor(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
 	if monitoring.IsMonitoringEnabled(pl) {
 		serviceMonitor, _, err := d.ensurers.ServiceMonitorByDeploymentModel(workflow).Ensure(ctx, workflow)
@@ -189,16 +348,22 @@ func (d *DeploymentReconciler) deploymentModelMutateVisitors(
 	managedPropsCM *v1.ConfigMap) []common.MutateVisitor {
 
 	if workflow.IsKnativeDeployment() {
-		return []common.MutateVisitor{common.KServiceMutateVisitor(workflow, plf),
+		visitors := []common.MutateVisitor{common.KServiceMutateVisitor(workflow, plf),
 			common.ImageKServiceMutateVisitor(workflow, image),
 			mountConfigMapsMutateVisitor(workflow, userPropsCM, managedPropsCM),
+			common.TLSMutateVisitor(workflow, plf),
 			common.RestoreKServiceVolumeAndVolumeMountMutateVisitor(),
 		}
+		if isRolloutEnabled(workflow) {
+			visitors = append(visitors, newRolloutHandler(d.StateSupport).trafficMutateVisitor(workflow))
+		}
+		return visitors
 	}
 
 	if utils.IsOpenShift() {
 		return []common.MutateVisitor{common.DeploymentMutateVisitor(workflow, plf),
 			mountConfigMapsMutateVisitor(workflow, userPropsCM, managedPropsCM),
+			common.TLSMutateVisitor(workflow, plf),
 			addOpenShiftImageTriggerDeploymentMutateVisitor(workflow, image),
 			common.ImageDeploymentMutateVisitor(workflow, image),
 			common.RestoreDeploymentVolumeAndVolumeMountMutateVisitor(),
@@ -208,6 +373,7 @@ func (d *DeploymentReconciler) deploymentModelMutateVisitors(
 	return []common.MutateVisitor{common.DeploymentMutateVisitor(workflow, plf),
 		common.ImageDeploymentMutateVisitor(workflow, image),
 		mountConfigMapsMutateVisitor(workflow, userPropsCM, managedPropsCM),
+		common.TLSMutateVisitor(workflow, plf),
 		common.RestoreDeploymentVolumeAndVolumeMountMutateVisitor(),
 		common.RolloutDeploymentIfCMChangedMutateVisitor(workflow, userPropsCM, managedPropsCM)}
 }
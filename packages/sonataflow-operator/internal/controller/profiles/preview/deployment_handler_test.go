@@ -26,10 +26,15 @@ import (
 	"github.com/magiconair/properties"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
@@ -229,3 +234,60 @@ func Test_CheckDeploymentUnchangedAfterCMChangeOtherKeys(t *testing.T) {
 		}
 	}
 }
+
+func Test_CheckHorizontalPodAutoscalerFollowsAutoscaling(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.Autoscaling = &v1alpha08.AutoscalingSpec{MaxReplicas: 3, TargetCPUUtilizationPercentage: ptr.To(int32(80))}
+
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, objects, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: workflow.Name, Namespace: workflow.Namespace}, hpa))
+	assert.Equal(t, int32(3), hpa.Spec.MaxReplicas)
+	assert.Contains(t, objects, client.Object(hpa))
+
+	deployment := &v1.Deployment{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: workflow.Name, Namespace: workflow.Namespace}, deployment))
+	assert.Nil(t, deployment.Spec.Replicas)
+
+	workflow.Spec.PodTemplate.Autoscaling = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(cli.Get(context.TODO(), types.NamespacedName{Name: workflow.Name, Namespace: workflow.Namespace}, hpa)))
+}
+
+func Test_CheckIngressFollowsExposure(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Host: "greeting.example.org"}
+
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, objects, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: workflow.Name, Namespace: workflow.Namespace}, ingress))
+	assert.Equal(t, "greeting.example.org", ingress.Spec.Rules[0].Host)
+	assert.Contains(t, objects, client.Object(ingress))
+	assert.Equal(t, "http://greeting.example.org/", workflow.Status.Endpoint.String())
+
+	workflow.Spec.Exposure = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(cli.Get(context.TODO(), types.NamespacedName{Name: workflow.Name, Namespace: workflow.Namespace}, ingress)))
+	assert.Nil(t, workflow.Status.Endpoint)
+}
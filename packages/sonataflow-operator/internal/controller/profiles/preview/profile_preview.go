@@ -62,7 +62,14 @@ type ObjectEnsurers struct {
 	// service for this ensurer. Don't call it directly, use ServiceByDeploymentModel instead
 	service common.ObjectEnsurer
 	// serviceMonitor for this ensurer. Don't call it directly, use ServiceMonitorByDeploymentModel instead
-	serviceMonitor        common.ObjectEnsurer
+	serviceMonitor common.ObjectEnsurer
+	// horizontalPodAutoscaler for this ensurer. Don't call it directly, use HorizontalPodAutoscalerByDeploymentModel instead
+	horizontalPodAutoscaler common.ObjectEnsurer
+	// podDisruptionBudget for this ensurer. Don't call it directly, use PodDisruptionBudgetByDeploymentModel instead
+	podDisruptionBudget common.ObjectEnsurer
+	// ingress and httpRoute expose the workflow outside the cluster, see DeploymentReconciler.ensureExposure
+	ingress               common.ObjectEnsurerWithPlatform
+	httpRoute             common.ObjectEnsurerWithPlatform
 	userPropsConfigMap    common.ObjectEnsurer
 	managedPropsConfigMap common.ObjectEnsurerWithPlatform
 }
@@ -93,15 +100,37 @@ func (o *ObjectEnsurers) ServiceMonitorByDeploymentModel(workflow *v1alpha08.Son
 	return o.serviceMonitor
 }
 
+// HorizontalPodAutoscalerByDeploymentModel gets the horizontal pod autoscaler ensurer based on the SonataFlow deployment model
+func (o *ObjectEnsurers) HorizontalPodAutoscalerByDeploymentModel(workflow *v1alpha08.SonataFlow) common.ObjectEnsurer {
+	if workflow.IsKnativeDeployment() {
+		// Knative Serving handles the autoscaling
+		return common.NewNoopObjectEnsurer()
+	}
+	return o.horizontalPodAutoscaler
+}
+
+// PodDisruptionBudgetByDeploymentModel gets the pod disruption budget ensurer based on the SonataFlow deployment model
+func (o *ObjectEnsurers) PodDisruptionBudgetByDeploymentModel(workflow *v1alpha08.SonataFlow) common.ObjectEnsurer {
+	if workflow.IsKnativeDeployment() {
+		// Knative Serving handles the revision pods
+		return common.NewNoopObjectEnsurer()
+	}
+	return o.podDisruptionBudget
+}
+
 // NewObjectEnsurers common.ObjectEnsurer(s) for the preview profile.
 func NewObjectEnsurers(support *common.StateSupport) *ObjectEnsurers {
 	return &ObjectEnsurers{
-		deployment:            common.NewObjectEnsurerWithPlatform(support.C, common.DeploymentCreator),
-		kservice:              common.NewObjectEnsurerWithPlatform(support.C, common.KServiceCreator),
-		service:               common.NewObjectEnsurer(support.C, common.ServiceCreator),
-		serviceMonitor:        common.NewObjectEnsurer(support.C, common.ServiceMonitorCreator),
-		userPropsConfigMap:    common.NewObjectEnsurer(support.C, common.UserPropsConfigMapCreator),
-		managedPropsConfigMap: common.NewObjectEnsurerWithPlatform(support.C, common.ManagedPropsConfigMapCreator),
+		deployment:              common.NewObjectEnsurerWithPlatform(support.C, common.DeploymentCreator),
+		kservice:                common.NewObjectEnsurerWithPlatform(support.C, common.KServiceCreator),
+		service:                 common.NewObjectEnsurer(support.C, common.ServiceCreator),
+		serviceMonitor:          common.NewObjectEnsurer(support.C, common.ServiceMonitorCreator),
+		horizontalPodAutoscaler: common.NewObjectEnsurer(support.C, common.HorizontalPodAutoscalerCreator),
+		podDisruptionBudget:     common.NewObjectEnsurer(support.C, common.PodDisruptionBudgetCreator),
+		ingress:                 common.NewObjectEnsurerWithPlatform(support.C, common.IngressCreator),
+		httpRoute:               common.NewObjectEnsurerWithPlatform(support.C, common.HTTPRouteCreator),
+		userPropsConfigMap:      common.NewObjectEnsurer(support.C, common.UserPropsConfigMapCreator),
+		managedPropsConfigMap:   common.NewObjectEnsurerWithPlatform(support.C, common.ManagedPropsConfigMapCreator),
 	}
 }
 
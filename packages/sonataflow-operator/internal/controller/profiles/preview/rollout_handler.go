@@ -0,0 +1,511 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preview
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/workflowproj"
+)
+
+const (
+	previewDeploymentSuffix = "-preview"
+	canaryTrafficTag        = "canary"
+	latestTrafficTag        = "latest"
+
+	defaultRolloutStepDuration     = time.Minute
+	defaultRolloutProgressDeadline = 10 * time.Minute
+)
+
+var defaultCanarySteps = []int32{10, 50}
+
+// rolloutHandler drives the spec.podTemplate.rollout strategies.
+//
+// In the "knative" deployment model, the KService traffic is pinned to the stable revision and the new revision only
+// receives the traffic of the current canary step. The "blueGreen" strategy is a canary rollout without steps.
+//
+// In the "kubernetes" deployment model, the new pod template runs in a second "<workflow>-preview" Deployment. Once it's
+// available, the workflow Service selects the preview pods while the workflow Deployment is updated, then the Service
+// selects the workflow pods again and the preview Deployment is removed.
+type rolloutHandler struct {
+	*common.StateSupport
+}
+
+func newRolloutHandler(support *common.StateSupport) *rolloutHandler {
+	return &rolloutHandler{StateSupport: support}
+}
+
+func isRolloutEnabled(workflow *operatorapi.SonataFlow) bool {
+	return workflow.Spec.PodTemplate.Rollout != nil
+}
+
+func getRolloutStrategy(rollout *operatorapi.RolloutSpec) operatorapi.RolloutStrategy {
+	if len(rollout.Strategy) == 0 {
+		return operatorapi.BlueGreenRolloutStrategy
+	}
+	return rollout.Strategy
+}
+
+func getCanarySteps(rollout *operatorapi.RolloutSpec) []int32 {
+	if getRolloutStrategy(rollout) != operatorapi.CanaryRolloutStrategy {
+		return nil
+	}
+	if len(rollout.CanarySteps) == 0 {
+		return defaultCanarySteps
+	}
+	return rollout.CanarySteps
+}
+
+func getRolloutStepDuration(rollout *operatorapi.RolloutSpec) time.Duration {
+	if rollout.StepDuration == nil {
+		return defaultRolloutStepDuration
+	}
+	return rollout.StepDuration.Duration
+}
+
+func getRolloutProgressDeadline(rollout *operatorapi.RolloutSpec) time.Duration {
+	if rollout.ProgressDeadline == nil {
+		return defaultRolloutProgressDeadline
+	}
+	return rollout.ProgressDeadline.Duration
+}
+
+func getPreviewDeploymentName(workflow *operatorapi.SonataFlow) string {
+	return workflow.Name + previewDeploymentSuffix
+}
+
+// getPreviewSelectorLabels the preview pods have their own instance label, so they are never selected by the workflow Deployment.
+func getPreviewSelectorLabels(workflow *operatorapi.SonataFlow) map[string]string {
+	lbl := workflowproj.GetSelectorLabels(workflow)
+	lbl[metadata.KubernetesLabelInstance] = getPreviewDeploymentName(workflow)
+	return lbl
+}
+
+// getPodTemplateHash identifies a pod template revision. The restartedAt annotation is ignored since it only triggers restarts.
+func getPodTemplateHash(template *corev1.PodTemplateSpec) (string, error) {
+	tpl := template.DeepCopy()
+	delete(tpl.Annotations, metadata.RestartedAt)
+	raw, err := json.Marshal(tpl)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(raw)
+	return hex.EncodeToString(hash[:])[:10], nil
+}
+
+// isDeploymentRolledOut is true once every replica of the Deployment runs the latest pod template.
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas &&
+		kubeutil.IsDeploymentAvailable(deployment)
+}
+
+func (r *rolloutHandler) status(workflow *operatorapi.SonataFlow) *operatorapi.RolloutStatus {
+	if workflow.Status.Rollout == nil {
+		workflow.Status.Rollout = &operatorapi.RolloutStatus{}
+	}
+	return workflow.Status.Rollout
+}
+
+func (r *rolloutHandler) start(workflow *operatorapi.SonataFlow, stable, canary string) {
+	now := metav1.Now()
+	status := r.status(workflow)
+	status.Phase = operatorapi.RolloutPhaseProgressing
+	status.StableRevision = stable
+	status.CanaryRevision = canary
+	status.TrafficPercent = 0
+	status.Step = 0
+	status.StartTime = &now
+	status.StepStartTime = nil
+	workflow.Status.Manager().MarkFalse(api.RolloutConditionType, api.RolloutProgressingReason, "Rolling out revision %s", canary)
+	r.Recorder.Eventf(workflow, corev1.EventTypeNormal, api.RolloutProgressingReason, "Rolling out revision %s of workflow %s.", canary, workflow.Name)
+	klog.V(log.I).InfoS("Workflow rollout started", "stable", stable, "canary", canary)
+}
+
+func (r *rolloutHandler) promote(workflow *operatorapi.SonataFlow) {
+	status := r.status(workflow)
+	status.Phase = operatorapi.RolloutPhasePromoting
+	status.TrafficPercent = 100
+	workflow.Status.Manager().MarkFalse(api.RolloutConditionType, api.RolloutPromotingReason, "Promoting revision %s", status.CanaryRevision)
+	klog.V(log.I).InfoS("Workflow rollout promoting", "canary", status.CanaryRevision)
+}
+
+func (r *rolloutHandler) succeed(workflow *operatorapi.SonataFlow, stable string) {
+	status := r.status(workflow)
+	if status.Phase == operatorapi.RolloutPhaseProgressing || status.Phase == operatorapi.RolloutPhasePromoting {
+		r.Recorder.Eventf(workflow, corev1.EventTypeNormal, api.RolloutSucceededReason, "Revision %s of workflow %s rolled out.", stable, workflow.Name)
+	}
+	status.Phase = operatorapi.RolloutPhaseSucceeded
+	status.StableRevision = stable
+	status.CanaryRevision = ""
+	status.TrafficPercent = 0
+	status.Step = 0
+	status.StepStartTime = nil
+	workflow.Status.Manager().MarkTrueWithReason(api.RolloutConditionType, api.RolloutSucceededReason, "Revision %s serves all the traffic", stable)
+}
+
+func (r *rolloutHandler) rollback(workflow *operatorapi.SonataFlow, reason string) {
+	status := r.status(workflow)
+	failed := status.CanaryRevision
+	status.Phase = operatorapi.RolloutPhaseRolledBack
+	status.FailedRevision = failed
+	status.CanaryRevision = ""
+	status.TrafficPercent = 0
+	status.Step = 0
+	status.StepStartTime = nil
+	workflow.Status.Manager().MarkFalse(api.RolloutConditionType, api.RolloutRolledBackReason, "Revision %s rolled back: %s", failed, reason)
+	r.Recorder.Eventf(workflow, corev1.EventTypeWarning, api.RolloutRolledBackReason, "Revision %s of workflow %s rolled back: %s", failed, workflow.Name, reason)
+	klog.V(log.I).InfoS("Workflow rollout rolled back", "failed", failed, "reason", reason)
+}
+
+func (r *rolloutHandler) isDeadlineExceeded(workflow *operatorapi.SonataFlow) bool {
+	status := workflow.Status.Rollout
+	if status == nil || status.StartTime == nil {
+		return false
+	}
+	return time.Since(status.StartTime.Time) > getRolloutProgressDeadline(workflow.Spec.PodTemplate.Rollout)
+}
+
+// cleanup removes the rollout leftovers once spec.podTemplate.rollout is removed from the workflow.
+func (r *rolloutHandler) cleanup(ctx context.Context, workflow *operatorapi.SonataFlow) error {
+	if isRolloutEnabled(workflow) || workflow.Status.Rollout == nil {
+		return nil
+	}
+	if workflow.IsKnativeDeployment() {
+		// give the traffic back to the latest revision
+		ksvc := &servingv1.Service{}
+		if err := r.C.Get(ctx, client.ObjectKeyFromObject(workflow), ksvc); err != nil && !errors.IsNotFound(err) {
+			return err
+		} else if err == nil && len(ksvc.Spec.Traffic) > 0 {
+			patch := client.MergeFrom(ksvc.DeepCopy())
+			ksvc.Spec.Traffic = nil
+			if err = r.C.Patch(ctx, ksvc, patch); err != nil {
+				return err
+			}
+		}
+	} else if err := r.deletePreviewDeployment(ctx, workflow); err != nil {
+		return err
+	}
+	workflow.Status.Rollout = nil
+	return workflow.Status.Manager().ClearCondition(api.RolloutConditionType)
+}
+
+// ensureBlueGreenDeployment is the "kubernetes" deployment model counterpart of the ObjectEnsurerWithPlatform.Ensure
+// call for the workflow Deployment. The workflow Deployment is only updated once the new pod template is promoted.
+func (r *rolloutHandler) ensureBlueGreenDeployment(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform,
+	ensurer common.ObjectEnsurerWithPlatform, visitors []common.MutateVisitor) (client.Object, controllerutil.OperationResult, error) {
+	desired, desiredHash, err := r.getDesiredDeployment(workflow, pl, visitors)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	visitors = append(visitors, templateHashMutateVisitor(desiredHash))
+	live := &appsv1.Deployment{}
+	if err = r.C.Get(ctx, client.ObjectKeyFromObject(workflow), live); err != nil {
+		if errors.IsNotFound(err) {
+			// first deployment, nothing to roll out
+			return ensurer.Ensure(ctx, workflow, pl, visitors...)
+		}
+		return nil, controllerutil.OperationResultNone, err
+	}
+	liveHash, hasHash := live.Annotations[metadata.RolloutTemplateHash]
+	status := r.status(workflow)
+
+	switch {
+	case !hasHash:
+		// deployed before enabling the rollout, the live pod template is adopted as the stable one
+		if equality.Semantic.DeepDerivative(desired.Spec.Template, live.Spec.Template) {
+			r.succeed(workflow, desiredHash)
+			return ensurer.Ensure(ctx, workflow, pl, visitors...)
+		}
+		if liveHash, err = r.adoptLiveTemplate(ctx, live); err != nil {
+			return nil, controllerutil.OperationResultNone, err
+		}
+		r.succeed(workflow, liveHash)
+	case desiredHash == liveHash:
+		if status.Phase == operatorapi.RolloutPhasePromoting && !isDeploymentRolledOut(live) {
+			// the Service keeps selecting the preview pods until the workflow Deployment is rolled out
+			return ensurer.Ensure(ctx, workflow, pl, visitors...)
+		}
+		if status.Phase != operatorapi.RolloutPhaseSucceeded || status.StableRevision != liveHash {
+			if err = r.deletePreviewDeployment(ctx, workflow); err != nil {
+				return nil, controllerutil.OperationResultNone, err
+			}
+			r.succeed(workflow, liveHash)
+		}
+		return ensurer.Ensure(ctx, workflow, pl, visitors...)
+	case desiredHash == status.FailedRevision:
+		// keep serving the stable revision until the workflow changes again
+		return ensurer.Ensure(ctx, workflow, pl, append(visitors, pinTemplateMutateVisitor(live))...)
+	case status.Phase == operatorapi.RolloutPhasePromoting && desiredHash == status.CanaryRevision:
+		return ensurer.Ensure(ctx, workflow, pl, visitors...)
+	}
+
+	if status.Phase != operatorapi.RolloutPhaseProgressing || status.CanaryRevision != desiredHash {
+		r.start(workflow, liveHash, desiredHash)
+	}
+	preview, err := r.ensurePreviewDeployment(ctx, workflow, desired)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	switch {
+	case isDeploymentRolledOut(preview):
+		r.promote(workflow)
+	case kubeutil.IsDeploymentFailed(preview):
+		if err = r.deletePreviewDeployment(ctx, workflow); err != nil {
+			return nil, controllerutil.OperationResultNone, err
+		}
+		r.rollback(workflow, common.GetDeploymentUnavailabilityMessage(preview))
+	case r.isDeadlineExceeded(workflow):
+		if err = r.deletePreviewDeployment(ctx, workflow); err != nil {
+			return nil, controllerutil.OperationResultNone, err
+		}
+		r.rollback(workflow, "progress deadline exceeded")
+	}
+	// the workflow Deployment keeps the stable pod template, the other changes are applied right away
+	return ensurer.Ensure(ctx, workflow, pl, append(visitors, pinTemplateMutateVisitor(live))...)
+}
+
+// adoptLiveTemplate stamps the hash of the live pod template on a Deployment created before enabling the rollout.
+func (r *rolloutHandler) adoptLiveTemplate(ctx context.Context, live *appsv1.Deployment) (string, error) {
+	hash, err := getPodTemplateHash(&live.Spec.Template)
+	if err != nil {
+		return "", err
+	}
+	patch := client.MergeFrom(live.DeepCopy())
+	if err = templateHashMutateVisitor(hash)(live)(); err != nil {
+		return "", err
+	}
+	return hash, r.C.Patch(ctx, live, patch)
+}
+
+// getDesiredDeployment builds the workflow Deployment as if it was created from scratch, so the pod template hash
+// doesn't depend on the default values set by the cluster.
+func (r *rolloutHandler) getDesiredDeployment(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform, visitors []common.MutateVisitor) (*appsv1.Deployment, string, error) {
+	object, err := common.DeploymentCreator(workflow, pl)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, v := range visitors {
+		if err = v(object)(); err != nil {
+			return nil, "", err
+		}
+	}
+	desired := object.(*appsv1.Deployment)
+	hash, err := getPodTemplateHash(&desired.Spec.Template)
+	return desired, hash, err
+}
+
+func templateHashMutateVisitor(hash string) common.MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			annotations := object.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[metadata.RolloutTemplateHash] = hash
+			object.SetAnnotations(annotations)
+			return nil
+		}
+	}
+}
+
+// pinTemplateMutateVisitor keeps the live pod template and its hash while a new pod template is rolled out or rolled back.
+func pinTemplateMutateVisitor(live *appsv1.Deployment) common.MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			deployment := object.(*appsv1.Deployment)
+			deployment.Spec.Template = *live.Spec.Template.DeepCopy()
+			return templateHashMutateVisitor(live.Annotations[metadata.RolloutTemplateHash])(deployment)()
+		}
+	}
+}
+
+func (r *rolloutHandler) ensurePreviewDeployment(ctx context.Context, workflow *operatorapi.SonataFlow, desired *appsv1.Deployment) (*appsv1.Deployment, error) {
+	selector := getPreviewSelectorLabels(workflow)
+	preview := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPreviewDeploymentName(workflow),
+			Namespace: workflow.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrPatch(ctx, r.C, preview, func() error {
+		lbl := workflowproj.GetMergedLabels(workflow)
+		maps.Copy(lbl, selector)
+		preview.Labels = lbl
+		preview.Spec.Replicas = desired.Spec.Replicas
+		if kubeutil.IsObjectNew(preview) {
+			preview.Spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		preview.Spec.Template = *desired.Spec.Template.DeepCopy()
+		delete(preview.Spec.Template.Annotations, metadata.RestartedAt)
+		preview.Spec.Template.Labels = maps.Clone(lbl)
+		return controllerutil.SetControllerReference(workflow, preview, r.C.Scheme())
+	})
+	return preview, err
+}
+
+func (r *rolloutHandler) deletePreviewDeployment(ctx context.Context, workflow *operatorapi.SonataFlow) error {
+	preview := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPreviewDeploymentName(workflow),
+			Namespace: workflow.Namespace,
+		},
+	}
+	if err := r.C.Delete(ctx, preview); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// serviceMutateVisitor points the workflow Service to the preview pods while the new pod template is promoted.
+func (r *rolloutHandler) serviceMutateVisitor(workflow *operatorapi.SonataFlow) common.MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			service := object.(*corev1.Service)
+			previewSelector := getPreviewSelectorLabels(workflow)
+			if workflow.Status.Rollout != nil && workflow.Status.Rollout.Phase == operatorapi.RolloutPhasePromoting {
+				service.Spec.Selector = previewSelector
+				return nil
+			}
+			if service.Spec.Selector[metadata.KubernetesLabelInstance] == previewSelector[metadata.KubernetesLabelInstance] {
+				original, err := common.ServiceCreator(workflow)
+				if err != nil {
+					return err
+				}
+				service.Spec.Selector = original.(*corev1.Service).Spec.Selector
+			}
+			return nil
+		}
+	}
+}
+
+// trafficMutateVisitor pins the KService traffic to the stable revision, the new revisions only get the canary traffic.
+func (r *rolloutHandler) trafficMutateVisitor(workflow *operatorapi.SonataFlow) common.MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			setRolloutTraffic(workflow, object.(*servingv1.Service))
+			return nil
+		}
+	}
+}
+
+func setRolloutTraffic(workflow *operatorapi.SonataFlow, ksvc *servingv1.Service) {
+	status := workflow.Status.Rollout
+	if status == nil || len(status.StableRevision) == 0 {
+		// let Knative route the traffic until the first revision is ready
+		return
+	}
+	if status.Phase == operatorapi.RolloutPhaseProgressing && len(status.CanaryRevision) > 0 {
+		ksvc.Spec.Traffic = []servingv1.TrafficTarget{
+			{RevisionName: status.StableRevision, Percent: ptr.To(int64(100 - status.TrafficPercent))},
+			{RevisionName: status.CanaryRevision, Percent: ptr.To(int64(status.TrafficPercent)), Tag: canaryTrafficTag},
+		}
+		return
+	}
+	ksvc.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: status.StableRevision, Percent: ptr.To(int64(100))},
+		{LatestRevision: ptr.To(true), Percent: ptr.To(int64(0)), Tag: latestTrafficTag},
+	}
+}
+
+// followKService moves the rollout forward based on the revisions reported by the KService.
+func (r *rolloutHandler) followKService(ctx context.Context, workflow *operatorapi.SonataFlow, ksvc *servingv1.Service) error {
+	if ksvc.Status.ObservedGeneration != ksvc.Generation {
+		// wait for Knative to catch up with the latest changes
+		return nil
+	}
+	rollout := workflow.Spec.PodTemplate.Rollout
+	status := r.status(workflow)
+	latestCreated := ksvc.Status.LatestCreatedRevisionName
+	latestReady := ksvc.Status.LatestReadyRevisionName
+
+	switch {
+	case len(status.StableRevision) == 0:
+		if len(latestReady) > 0 {
+			r.succeed(workflow, latestReady)
+		}
+	case len(latestCreated) == 0 || latestCreated == status.StableRevision || latestCreated == status.FailedRevision:
+		// nothing new to roll out
+	default:
+		if status.Phase != operatorapi.RolloutPhaseProgressing || status.CanaryRevision != latestCreated {
+			r.start(workflow, status.StableRevision, latestCreated)
+		}
+		steps := getCanarySteps(rollout)
+		switch {
+		case latestReady == latestCreated:
+			now := metav1.Now()
+			switch {
+			case status.StepStartTime == nil && len(steps) > 0:
+				status.TrafficPercent = steps[0]
+				status.StepStartTime = &now
+			case status.StepStartTime != nil && time.Since(status.StepStartTime.Time) < getRolloutStepDuration(rollout):
+				// keep the current step
+			case int(status.Step)+1 < len(steps):
+				status.Step++
+				status.TrafficPercent = steps[status.Step]
+				status.StepStartTime = &now
+			default:
+				r.succeed(workflow, latestCreated)
+			}
+		case ksvc.Status.GetCondition(servingv1.ServiceConditionConfigurationsReady).IsFalse():
+			r.rollback(workflow, ksvc.Status.GetCondition(servingv1.ServiceConditionConfigurationsReady).Message)
+		case r.isDeadlineExceeded(workflow):
+			r.rollback(workflow, "progress deadline exceeded")
+		}
+	}
+
+	original := ksvc.DeepCopy()
+	setRolloutTraffic(workflow, ksvc)
+	if equality.Semantic.DeepEqual(original.Spec.Traffic, ksvc.Spec.Traffic) {
+		return nil
+	}
+	if err := r.C.Patch(ctx, ksvc, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to update the traffic of the Knative Service %s: %v", ksvc.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
+)
+
+func markDeploymentRolledOut(t *testing.T, cli client.Client, name, namespace string) {
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, deployment))
+	deployment.Status.ObservedGeneration = deployment.Generation
+	deployment.Status.UpdatedReplicas = 1
+	deployment.Status.AvailableReplicas = 1
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+	assert.NoError(t, cli.Status().Update(context.TODO(), deployment))
+}
+
+func getWorkflowDeployment(t *testing.T, cli client.Client, name, namespace string) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, deployment))
+	return deployment
+}
+
+// getRolloutWorkflowAndPlatform the workflow refers to the platform from the beginning, otherwise the pod template changes
+// after the first reconciliation.
+func getRolloutWorkflowAndPlatform(namespace string) (*v1alpha08.SonataFlow, *v1alpha08.SonataFlowPlatform) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(namespace)
+	plf := test.GetBasePlatformInReadyPhase(namespace)
+	workflow.Status.Platform = &v1alpha08.SonataFlowPlatformRef{Name: plf.Name, Namespace: plf.Namespace}
+	return workflow, plf
+}
+
+func Test_BlueGreenRolloutPromotesPreviewDeployment(t *testing.T) {
+	workflow, plf := getRolloutWorkflowAndPlatform(t.Name())
+	workflow.Spec.PodTemplate.Rollout = &v1alpha08.RolloutSpec{Strategy: v1alpha08.BlueGreenRolloutStrategy}
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow, plf).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+	previewName := getPreviewDeploymentName(workflow)
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseSucceeded, workflow.Status.Rollout.Phase)
+	stable := workflow.Status.Rollout.StableRevision
+	assert.NotEmpty(t, stable)
+	assert.True(t, workflow.Status.GetCondition(api.RolloutConditionType).IsTrue())
+
+	// a new image is rolled out in the preview Deployment
+	workflow.Spec.PodTemplate.Container.Image = test.CommonImageTag
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseProgressing, workflow.Status.Rollout.Phase)
+	assert.Equal(t, api.RolloutProgressingReason, workflow.Status.GetCondition(api.RolloutConditionType).Reason)
+	assert.NotEqual(t, test.CommonImageTag, getWorkflowDeployment(t, cli, workflow.Name, workflow.Namespace).Spec.Template.Spec.Containers[0].Image)
+	preview := getWorkflowDeployment(t, cli, previewName, workflow.Namespace)
+	assert.Equal(t, test.CommonImageTag, preview.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, previewName, preview.Spec.Selector.MatchLabels[metadata.KubernetesLabelInstance])
+
+	// the preview is healthy, the Service selects its pods
+	markDeploymentRolledOut(t, cli, previewName, workflow.Namespace)
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhasePromoting, workflow.Status.Rollout.Phase)
+	service := &corev1.Service{}
+	assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(workflow), service))
+	assert.Equal(t, previewName, service.Spec.Selector[metadata.KubernetesLabelInstance])
+
+	// the workflow Deployment gets the new image
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, test.CommonImageTag, getWorkflowDeployment(t, cli, workflow.Name, workflow.Namespace).Spec.Template.Spec.Containers[0].Image)
+
+	// once rolled out, the Service selects the workflow pods again and the preview is removed
+	markDeploymentRolledOut(t, cli, workflow.Name, workflow.Namespace)
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseSucceeded, workflow.Status.Rollout.Phase)
+	assert.NotEqual(t, stable, workflow.Status.Rollout.StableRevision)
+	assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(workflow), service))
+	assert.Equal(t, workflow.Name, service.Spec.Selector[metadata.KubernetesLabelInstance])
+	err = cli.Get(context.TODO(), types.NamespacedName{Name: previewName, Namespace: workflow.Namespace}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func Test_BlueGreenRolloutRollsBackFailedPreview(t *testing.T) {
+	workflow, plf := getRolloutWorkflowAndPlatform(t.Name())
+	workflow.Spec.PodTemplate.Rollout = &v1alpha08.RolloutSpec{Strategy: v1alpha08.BlueGreenRolloutStrategy}
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow, plf).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+	previewName := getPreviewDeploymentName(workflow)
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	workflow.Spec.PodTemplate.Container.Image = test.CommonImageTag
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseProgressing, workflow.Status.Rollout.Phase)
+
+	preview := getWorkflowDeployment(t, cli, previewName, workflow.Namespace)
+	preview.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionTrue, Message: "image not found"}}
+	assert.NoError(t, cli.Status().Update(context.TODO(), preview))
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseRolledBack, workflow.Status.Rollout.Phase)
+	assert.NotEmpty(t, workflow.Status.Rollout.FailedRevision)
+	assert.Equal(t, api.RolloutRolledBackReason, workflow.Status.GetCondition(api.RolloutConditionType).Reason)
+	err = cli.Get(context.TODO(), types.NamespacedName{Name: previewName, Namespace: workflow.Namespace}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err))
+
+	// the failed revision isn't rolled out again, but the other changes are applied
+	workflow.Spec.PodTemplate.Replicas = ptr.To(int32(3))
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseRolledBack, workflow.Status.Rollout.Phase)
+	deployment := getWorkflowDeployment(t, cli, workflow.Name, workflow.Namespace)
+	assert.NotEqual(t, test.CommonImageTag, deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+}
+
+func Test_BlueGreenRolloutAdoptsLiveDeployment(t *testing.T) {
+	workflow, plf := getRolloutWorkflowAndPlatform(t.Name())
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow, plf).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.NotContains(t, getWorkflowDeployment(t, cli, workflow.Name, workflow.Namespace).Annotations, metadata.RolloutTemplateHash)
+
+	// the rollout is enabled together with a new image
+	workflow.Spec.PodTemplate.Rollout = &v1alpha08.RolloutSpec{Strategy: v1alpha08.BlueGreenRolloutStrategy}
+	workflow.Spec.PodTemplate.Container.Image = test.CommonImageTag
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseProgressing, workflow.Status.Rollout.Phase)
+	deployment := getWorkflowDeployment(t, cli, workflow.Name, workflow.Namespace)
+	assert.NotEqual(t, test.CommonImageTag, deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, workflow.Status.Rollout.StableRevision, deployment.Annotations[metadata.RolloutTemplateHash])
+	preview := getWorkflowDeployment(t, cli, getPreviewDeploymentName(workflow), workflow.Namespace)
+	assert.Equal(t, test.CommonImageTag, preview.Spec.Template.Spec.Containers[0].Image)
+}
+
+func Test_CanaryRolloutSplitsKnativeTraffic(t *testing.T) {
+	workflow, plf := getRolloutWorkflowAndPlatform(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.PodTemplate.Rollout = &v1alpha08.RolloutSpec{Strategy: v1alpha08.CanaryRolloutStrategy, CanarySteps: []int32{20}}
+	cli := test.NewSonataFlowClientBuilderWithKnative().
+		WithRuntimeObjects(workflow, plf).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(cli)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+	setRevisions := func(latestCreated, latestReady string, conditions ...apis.Condition) *servingv1.Service {
+		ksvc := &servingv1.Service{}
+		assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(workflow), ksvc))
+		ksvc.Status.ObservedGeneration = ksvc.Generation
+		ksvc.Status.LatestCreatedRevisionName = latestCreated
+		ksvc.Status.LatestReadyRevisionName = latestReady
+		ksvc.Status.Conditions = conditions
+		assert.NoError(t, cli.Update(context.TODO(), ksvc))
+		return ksvc
+	}
+	getTraffic := func() []servingv1.TrafficTarget {
+		ksvc := &servingv1.Service{}
+		assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(workflow), ksvc))
+		return ksvc.Spec.Traffic
+	}
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	setRevisions("greeting-00001", "greeting-00001")
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "greeting-00001", workflow.Status.Rollout.StableRevision)
+	assert.Equal(t, "greeting-00001", getTraffic()[0].RevisionName)
+	assert.Equal(t, int64(100), *getTraffic()[0].Percent)
+
+	// the new revision gets the first step traffic once ready
+	setRevisions("greeting-00002", "greeting-00002")
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseProgressing, workflow.Status.Rollout.Phase)
+	assert.Equal(t, int32(20), workflow.Status.Rollout.TrafficPercent)
+	traffic := getTraffic()
+	assert.Len(t, traffic, 2)
+	assert.Equal(t, int64(80), *traffic[0].Percent)
+	assert.Equal(t, "greeting-00002", traffic[1].RevisionName)
+	assert.Equal(t, int64(20), *traffic[1].Percent)
+
+	// the last step elapsed, the new revision is promoted
+	workflow.Status.Rollout.StepStartTime = &metav1.Time{Time: time.Now().Add(-2 * defaultRolloutStepDuration)}
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseSucceeded, workflow.Status.Rollout.Phase)
+	assert.Equal(t, "greeting-00002", workflow.Status.Rollout.StableRevision)
+	assert.Equal(t, "greeting-00002", getTraffic()[0].RevisionName)
+	assert.Equal(t, int64(100), *getTraffic()[0].Percent)
+
+	// a failing revision is rolled back
+	setRevisions("greeting-00003", "greeting-00002", apis.Condition{Type: servingv1.ServiceConditionConfigurationsReady, Status: corev1.ConditionFalse, Message: "container failed"})
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, "")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.RolloutPhaseRolledBack, workflow.Status.Rollout.Phase)
+	assert.Equal(t, "greeting-00003", workflow.Status.Rollout.FailedRevision)
+	assert.Equal(t, "greeting-00002", getTraffic()[0].RevisionName)
+	assert.Equal(t, int64(100), *getTraffic()[0].Percent)
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+	kubeutil "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils/kubernetes"
 )
 
 type newBuilderState struct {
@@ -223,6 +224,17 @@ func (h *deployWithBuildWorkflowState) Do(ctx context.Context, workflow *operato
 		_, err = h.PerformStatusUpdate(ctx, workflow)
 		return result, nil, err
 	}
+	if isReusedImageUnavailable(workflow, build) {
+		// the image reused from the build cache is gone, the workflow is built again
+		if err = buildManager.EvictReusedImage(build); err != nil {
+			return ctrl.Result{}, nil, err
+		}
+		workflow.Status.Manager().MarkFalse(api.BuiltConditionType, api.BuildIsRunningReason, "Build marked to restart, the reused image can't be pulled")
+		workflow.Status.Manager().MarkUnknown(api.RunningConditionType, "", "")
+		_, err = h.PerformStatusUpdate(ctx, workflow)
+		h.Recorder.Eventf(workflow, corev1.EventTypeWarning, api.BuildMarkedToRestartReason, "Workflow %s will start a new build, the reused image %s can't be pulled.", workflow.Name, build.Status.ImageTag)
+		return ctrl.Result{Requeue: false}, nil, err
+	}
 	return result, objs, err
 }
 
@@ -231,6 +243,12 @@ func (h *deployWithBuildWorkflowState) PostReconcile(ctx context.Context, workfl
 	return common.CleanupOutdatedRevisions(ctx, h.Cfg, workflow)
 }
 
+// isReusedImageUnavailable checks whether the deployment can't pull the image the build reused from the build cache.
+func isReusedImageUnavailable(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) bool {
+	running := workflow.Status.GetCondition(api.RunningConditionType)
+	return build.Status.Reused && running != nil && kubeutil.IsImagePullFailure(running.Message)
+}
+
 // isWorkflowChanged checks whether the contents of .spec.flow of the given workflow has changed.
 func (h *deployWithBuildWorkflowState) isWorkflowChanged(workflow *operatorapi.SonataFlow) (bool, error) {
 	// Added this guard for backward compatibility for workflows deployed with a previous operator version, so we won't kick thousands of builds on users' cluster.
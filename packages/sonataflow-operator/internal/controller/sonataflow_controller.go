@@ -27,12 +27,14 @@ import (
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/manager"
 
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/discovery"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/eventing"
 
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
 
 	"k8s.io/client-go/util/retry"
 
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/properties"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/workflowdef"
 
@@ -46,12 +48,16 @@ import (
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/monitoring"
 
-	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
 	profilesfactory "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/factory"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/client-go/rest"
 
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -62,6 +68,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -88,6 +95,15 @@ type SonataFlowReconciler struct {
 //+kubebuilder:rbac:groups=sonataflow.org,resources=sonataflows/finalizers,verbs=update
 //+kubebuilder:rbac:groups="monitoring.coreos.com",resources=servicemonitors,verbs=get;list;watch;create;update;delete
 //+kubebuilder:rbac:groups="serving.knative.dev",resources=revisions,verbs=list;watch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=cronjobs;jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices;serviceentries,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -112,13 +128,15 @@ func (r *SonataFlowReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	err := r.Client.Get(ctx, req.NamespacedName, workflow)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			discovery.UntrackWorkflow(req.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		klog.V(log.E).ErrorS(err, "Failed to get SonataFlow")
 		return ctrl.Result{}, err
 	}
 
-	r.setDefaults(workflow)
+	// Defaults are also applied by the mutating webhook, we keep them here for clusters running without webhooks.
+	workflowdef.SetDefaults(workflow)
 	// If the workflow is being deleted, execute the associated finalizers
 	if workflow.DeletionTimestamp != nil {
 		return r.applyFinalizers(ctx, workflow)
@@ -142,18 +160,6 @@ func (r *SonataFlowReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return profilesfactory.NewReconciler(r.Client, r.Config, r.Recorder, workflow).Reconcile(ctx, workflow)
 }
 
-// TODO: move to webhook see https://github.com/apache/incubator-kie-tools/packages/sonataflow-operator/pull/239
-func (r *SonataFlowReconciler) setDefaults(workflow *operatorapi.SonataFlow) {
-	if workflow.Annotations == nil {
-		workflow.Annotations = map[string]string{}
-	}
-	profile := metadata.GetProfileOrDefault(workflow.Annotations)
-	workflow.Annotations[metadata.Profile] = string(profile)
-	if profile == metadata.DevProfile {
-		workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KubernetesDeploymentModel
-	}
-}
-
 // applyFinalizers Manages the execution of the workflow finalizers.
 func (r *SonataFlowReconciler) applyFinalizers(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, error) {
 	if controllerutil.ContainsFinalizer(workflow, constants.TriggerFinalizer) {
@@ -326,12 +332,75 @@ func buildEnqueueRequestsFromMapFunc(c client.Client, b *operatorapi.SonataFlowB
 	return requests
 }
 
+// secretEnqueueRequestsFromMapFunc wakes up the workflows reading their persistence credentials from the given Secret,
+// so a credentials rotation rolls the workflow pods out.
+func secretEnqueueRequestsFromMapFunc(c client.Client, secret client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &operatorapi.SonataFlowList{}
+	if err := c.List(context.Background(), list, client.InNamespace(secret.GetNamespace())); err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to list workflows")
+		return requests
+	}
+	if len(list.Items) == 0 {
+		return requests
+	}
+	pl, _ := platform.GetActivePlatform(context.Background(), c, secret.GetNamespace(), false)
+	for i := range list.Items {
+		workflow := &list.Items[i]
+		if persistence.UsesSecret(persistence.ResolveWorkflowProvider(workflow, pl), secret.GetName()) {
+			klog.V(log.I).InfoS("Persistence secret changed, wake-up workflow", "secret", secret.GetName(), "workflow", workflow.Name)
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(workflow)})
+		}
+	}
+	return requests
+}
+
+// secretPredicate filters the Secret events before they're mapped, the Secret must be referenced and its updates must
+// change the object. Secrets are watched by their metadata only, so their data can't be compared here, the workloads
+// are only rolled out when the checksum of the credentials they read changes.
+func secretPredicate(isReferenced func(object client.Object) bool) predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isReferenced(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isReferenced(e.ObjectNew) && e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isReferenced(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// discoveryEnqueueRequestsFromMapFunc wakes up the workflows having service discovery uris resolved from the given
+// object, so their addresses are calculated again and only the workflows whose addresses changed are rolled out.
+func discoveryEnqueueRequestsFromMapFunc(object client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, workflow := range discovery.GetWorkflowsResolvedFrom(object) {
+		klog.V(log.I).InfoS("Service discovery resource changed, wake-up workflow", "resource", object.GetName(), "namespace", object.GetNamespace(), "workflow", workflow.Name)
+		requests = append(requests, reconcile.Request{NamespacedName: workflow})
+	}
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&operatorapi.SonataFlow{}).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
+				if _, isSecret := e.ObjectNew.(*metav1.PartialObjectMetadata); isSecret {
+					// Secrets have no generation, their updates are filtered by the secretPredicate instead.
+					return true
+				}
+				if discovery.IsResolvedFrom(e.ObjectNew) {
+					// Addresses are mostly calculated from the status or other fields not bumping the generation.
+					return true
+				}
 				oldGeneration := e.ObjectOld.GetGeneration()
 				newGeneration := e.ObjectNew.GetGeneration()
 				// Generation is only updated on spec changes (also on deletion), not upon metadata or status changes.
@@ -342,6 +411,11 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&batchv1.CronJob{}).
 		Owns(&operatorapi.SonataFlowBuild{}).
 		Watches(&operatorapi.SonataFlowPlatform{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
 			plat, ok := a.(*operatorapi.SonataFlowPlatform)
@@ -358,6 +432,23 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				return []reconcile.Request{}
 			}
 			return buildEnqueueRequestsFromMapFunc(mgr.GetClient(), build)
+		})).
+		// Secrets are watched by their metadata only, so their content isn't cached. The operator reads them straight
+		// from the API server instead, see the manager client options.
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+			return append(secretEnqueueRequestsFromMapFunc(mgr.GetClient(), a), discoveryEnqueueRequestsFromMapFunc(a)...)
+		}), ctrlbuilder.OnlyMetadata, ctrlbuilder.WithPredicates(secretPredicate(func(object client.Object) bool {
+			return persistence.IsUsedSecret(object) || discovery.IsResolvedFrom(object)
+		}))).
+		// ConfigMaps share the informer of the owned ConfigMaps above, watching them adds no cache.
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+			return discoveryEnqueueRequestsFromMapFunc(a)
+		})).
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+			return discoveryEnqueueRequestsFromMapFunc(a)
+		})).
+		Watches(&networkingv1.Ingress{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+			return discoveryEnqueueRequestsFromMapFunc(a)
 		}))
 
 	knativeAvail, err := knative.GetKnativeAvailability(mgr.GetConfig())
@@ -365,12 +456,19 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 	if knativeAvail.Serving {
-		builder = builder.Owns(&servingv1.Service{})
+		builder = builder.Owns(&servingv1.Service{}).
+			Watches(&servingv1.Service{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+				return discoveryEnqueueRequestsFromMapFunc(a)
+			}))
 	}
 	if knativeAvail.Eventing {
 		builder = builder.Owns(&eventingv1.Trigger{}).
 			Owns(&sourcesv1.SinkBinding{}).
-			Watches(&eventingv1.Trigger{}, handler.EnqueueRequestsFromMapFunc(knative.MapTriggerToPlatformRequests))
+			Owns(&sourcesv1.PingSource{}).
+			Watches(&eventingv1.Trigger{}, handler.EnqueueRequestsFromMapFunc(knative.MapTriggerToPlatformRequests)).
+			Watches(&eventingv1.Broker{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+				return discoveryEnqueueRequestsFromMapFunc(a)
+			}))
 	}
 	promAvail, err := monitoring.GetPrometheusAvailability(mgr.GetConfig())
 	if err != nil {
@@ -379,6 +477,29 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if promAvail {
 		builder = builder.Owns(&prometheus.ServiceMonitor{})
 	}
+	gatewayAPIAvail, err := common.GetGatewayAPIAvailability(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if gatewayAPIAvail {
+		builder = builder.Owns(common.NewHTTPRoute("", ""))
+		for _, object := range discovery.GetGatewayAPIResources() {
+			builder = builder.Watches(object, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+				return discoveryEnqueueRequestsFromMapFunc(a)
+			}))
+		}
+	}
+	istioAvail, err := discovery.GetIstioAvailability(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if istioAvail {
+		for _, object := range discovery.GetIstioResources() {
+			builder = builder.Watches(object, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
+				return discoveryEnqueueRequestsFromMapFunc(a)
+			}))
+		}
+	}
 
 	return builder.Complete(r)
 }
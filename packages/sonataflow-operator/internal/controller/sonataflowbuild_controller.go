@@ -63,6 +63,7 @@ const (
 // +kubebuilder:rbac:groups=sonataflow.org,resources=sonataflowbuilds,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sonataflow.org,resources=sonataflowbuilds/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sonataflow.org,resources=sonataflowbuilds/finalizers,verbs=update
+// +kubebuilder:rbac:groups=tekton.dev,resources=pipelineruns,verbs=get;list;watch;create;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -138,11 +139,23 @@ func (r *SonataFlowBuildReconciler) manageStatusUpdate(ctx context.Context, inst
 	err := r.Status().Update(ctx, instance)
 	// Don't need to spam events if the phase hasn't changed
 	if err == nil && beforeReconcilePhase != instance.Status.BuildPhase {
-		r.Recorder.Event(instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated buildphase to %s", instance.Status.BuildPhase))
+		r.recordPhaseTransition(instance, beforeReconcilePhase)
 	}
 	return err
 }
 
+// recordPhaseTransition emits an event for the build phase transition, the failures are reported as warnings with the last error.
+func (r *SonataFlowBuildReconciler) recordPhaseTransition(instance *operatorapi.SonataFlowBuild, beforeReconcilePhase operatorapi.BuildPhase) {
+	phase := instance.Status.BuildPhase
+	switch phase {
+	case operatorapi.BuildPhaseFailed, operatorapi.BuildPhaseError, operatorapi.BuildPhaseInterrupted:
+		r.Recorder.Event(instance, corev1.EventTypeWarning, string(phase),
+			fmt.Sprintf("Updated buildphase from %s to %s: %s", beforeReconcilePhase, phase, instance.Status.Error))
+	default:
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated buildphase to %s", phase))
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SonataFlowBuildReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if utils.IsOpenShift() {
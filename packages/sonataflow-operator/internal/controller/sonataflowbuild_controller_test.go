@@ -138,3 +138,26 @@ func TestSonataFlowBuildController_MarkToRestart(t *testing.T) {
 	ksb = test.MustGetBuild(t, cl, types.NamespacedName{Name: ksb.Name, Namespace: namespace})
 	assert.Equal(t, "false", ksb.Annotations[operatorapi.BuildRestartAnnotation])
 }
+
+func TestSonataFlowBuildController_FailedBuildEvent(t *testing.T) {
+	namespace := t.Name()
+	ksw := test.GetBaseSonataFlow(namespace)
+	ksb := test.GetNewEmptySonataFlowBuild(ksw.Name, namespace)
+
+	cl := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(ksb, ksw).
+		WithStatusSubresource(ksb, ksw).
+		Build()
+
+	recorder := record.NewFakeRecorder(2)
+	r := &SonataFlowBuildReconciler{cl, cl.Scheme(), recorder, &rest.Config{}}
+
+	ksb.Status.BuildPhase = operatorapi.BuildPhaseFailed
+	ksb.Status.Error = "Pod failed"
+	ksb.Status.Logs = "error: exit status 1"
+	assert.NoError(t, r.manageStatusUpdate(context.TODO(), ksb, operatorapi.BuildPhaseRunning))
+	assert.Equal(t, "Warning Failed Updated buildphase from Running to Failed: Pod failed", <-recorder.Events)
+
+	ksb = test.MustGetBuild(t, cl, types.NamespacedName{Name: ksb.Name, Namespace: namespace})
+	assert.Equal(t, "error: exit status 1", ksb.Status.Logs)
+}
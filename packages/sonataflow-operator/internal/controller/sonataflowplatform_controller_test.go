@@ -26,7 +26,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
@@ -34,12 +38,15 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/clusterplatform"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform/services"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
 	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/utils"
 )
@@ -170,6 +177,158 @@ func TestSonataFlowPlatformController(t *testing.T) {
 		assert.Contains(t, dep.Spec.Template.Spec.Containers[1].Env, env)
 	})
 
+	t.Run("verify that the data index disruption budget and topology spread are reconciled", func(t *testing.T) {
+		namespace := t.Name()
+		ksp := test.GetBasePlatformInReadyPhase(namespace)
+		maxUnavailable := intstr.FromString("50%")
+		ksp.Spec.Services = &v1alpha08.ServicesPlatformSpec{
+			DataIndex: &v1alpha08.DataIndexServiceSpec{ServiceSpec: v1alpha08.ServiceSpec{PodTemplate: v1alpha08.PodTemplateSpec{
+				DisruptionBudget: &v1alpha08.DisruptionBudgetSpec{MaxUnavailable: &maxUnavailable},
+				TopologySpread:   &v1alpha08.TopologySpreadSpec{},
+			}}},
+		}
+
+		cl := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(ksp).WithStatusSubresource(ksp).Build()
+		utils.SetClient(cl)
+		r := &SonataFlowPlatformReconciler{cl, cl, cl.Scheme(), &rest.Config{}, &record.FakeRecorder{}}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ksp.Name, Namespace: ksp.Namespace}}
+		_, err := r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		di := services.NewDataIndexHandler(ksp)
+		pdb := &policyv1.PodDisruptionBudget{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, pdb))
+		assert.Equal(t, maxUnavailable, *pdb.Spec.MaxUnavailable)
+		assert.True(t, metav1.IsControlledBy(pdb, ksp))
+
+		dep := &appsv1.Deployment{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, dep))
+		assert.Len(t, dep.Spec.Template.Spec.TopologySpreadConstraints, 2)
+		assert.Equal(t, dep.Spec.Selector, dep.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector)
+
+		// disabling both removes the budget and the constraints
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: ksp.Name, Namespace: ksp.Namespace}, ksp))
+		ksp.Spec.Services.DataIndex.PodTemplate.DisruptionBudget = nil
+		ksp.Spec.Services.DataIndex.PodTemplate.TopologySpread = nil
+		assert.NoError(t, cl.Update(context.TODO(), ksp))
+		_, err = r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		assert.True(t, errors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, pdb)))
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, dep))
+		assert.Empty(t, dep.Spec.Template.Spec.TopologySpreadConstraints)
+	})
+
+	t.Run("verify that the managed postgresql instance is provisioned and wired into the platform", func(t *testing.T) {
+		namespace := t.Name()
+		ksp := test.GetBasePlatformInReadyPhase(namespace)
+		ksp.Spec.Persistence = &v1alpha08.PlatformPersistenceOptionsSpec{
+			PostgreSQL: &v1alpha08.PlatformPersistencePostgreSQL{Managed: &v1alpha08.ManagedPostgreSQLSpec{}},
+		}
+		ksp.Spec.Services = &v1alpha08.ServicesPlatformSpec{
+			DataIndex: &v1alpha08.DataIndexServiceSpec{},
+		}
+
+		cl := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(ksp).WithStatusSubresource(ksp, &appsv1.StatefulSet{}).Build()
+		utils.SetClient(cl)
+		r := &SonataFlowPlatformReconciler{cl, cl, cl.Scheme(), &rest.Config{}, &record.FakeRecorder{}}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ksp.Name, Namespace: ksp.Namespace}}
+		_, err := r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		managedName := types.NamespacedName{Name: ksp.Name + "-postgresql", Namespace: ksp.Namespace}
+		secret := &corev1.Secret{}
+		assert.NoError(t, cl.Get(context.TODO(), managedName, secret))
+		assert.NotEmpty(t, secret.StringData["POSTGRES_PASSWORD"])
+		assert.True(t, metav1.IsControlledBy(secret, ksp))
+		pvc := &corev1.PersistentVolumeClaim{}
+		assert.NoError(t, cl.Get(context.TODO(), managedName, pvc))
+		assert.True(t, metav1.IsControlledBy(pvc, ksp))
+		assert.NoError(t, cl.Get(context.TODO(), managedName, &corev1.Service{}))
+		sts := &appsv1.StatefulSet{}
+		assert.NoError(t, cl.Get(context.TODO(), managedName, sts))
+		assert.True(t, metav1.IsControlledBy(sts, ksp))
+		assert.Equal(t, managedName.Name, sts.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+		// the services wait for the database to be ready
+		di := services.NewDataIndexHandler(ksp)
+		dep := &appsv1.Deployment{}
+		assert.True(t, errors.IsNotFound(cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, dep)))
+
+		sts.Status.Replicas = 1
+		sts.Status.ReadyReplicas = 1
+		assert.NoError(t, cl.Status().Update(context.TODO(), sts))
+		_, err = r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: ksp.Name, Namespace: ksp.Namespace}, ksp))
+		assert.Equal(t, managedName.Name, ksp.Spec.Persistence.PostgreSQL.SecretRef.Name)
+		assert.Equal(t, managedName.Name, ksp.Spec.Persistence.PostgreSQL.ServiceRef.Name)
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: ksp.Namespace}, dep))
+		assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "QUARKUS_DATASOURCE_JDBC_URL",
+			Value: "jdbc:postgresql://" + managedName.Name + "." + ksp.Namespace + ":5432/sonataflow?currentSchema=" + di.GetServiceName(),
+		})
+	})
+
+	t.Run("verify that the platform services roll out when the persistence secret is rotated", func(t *testing.T) {
+		namespace := t.Name()
+		ksp := test.GetBasePlatformInReadyPhase(namespace)
+		ksp.Spec.Persistence = &v1alpha08.PlatformPersistenceOptionsSpec{
+			PostgreSQL: &v1alpha08.PlatformPersistencePostgreSQL{
+				SecretRef:  v1alpha08.PostgreSQLSecretOptions{Name: "postgres-secret"},
+				ServiceRef: &v1alpha08.SQLServiceOptions{Name: "postgres"},
+			},
+		}
+		ksp.Spec.Services = &v1alpha08.ServicesPlatformSpec{
+			DataIndex: &v1alpha08.DataIndexServiceSpec{},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "postgres-secret", Namespace: namespace},
+			Data:       map[string][]byte{"POSTGRESQL_USER": []byte("sonataflow"), "POSTGRESQL_PASSWORD": []byte("first")},
+		}
+
+		cl := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(ksp, secret).WithStatusSubresource(ksp).Build()
+		utils.SetClient(cl)
+		r := &SonataFlowPlatformReconciler{cl, cl, cl.Scheme(), &rest.Config{}, &record.FakeRecorder{}}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ksp.Name, Namespace: ksp.Namespace}}
+		_, err := r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		di := services.NewDataIndexHandler(ksp)
+		dep := &appsv1.Deployment{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: namespace}, dep))
+		checksum := dep.Spec.Template.Annotations[metadata.PersistenceSecretChecksum]
+		assert.NotEmpty(t, checksum)
+
+		assert.Equal(t, []reconcile.Request{req}, r.mapSecretToPlatformRequests(context.TODO(), secret))
+		unrelated := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: namespace}}
+		assert.Empty(t, r.mapSecretToPlatformRequests(context.TODO(), unrelated))
+
+		// only the changes of the persistence secrets are mapped, the secrets are watched by their metadata only
+		secretEvents := secretPredicate(persistence.IsUsedSecret)
+		secretMetadata := &metav1.PartialObjectMetadata{ObjectMeta: secret.ObjectMeta}
+		secretMetadata.ResourceVersion = "1"
+		unrelatedMetadata := &metav1.PartialObjectMetadata{ObjectMeta: unrelated.ObjectMeta}
+		rotatedUnrelated := unrelatedMetadata.DeepCopy()
+		rotatedUnrelated.ResourceVersion = "2"
+		assert.False(t, secretEvents.Update(event.UpdateEvent{ObjectOld: unrelatedMetadata, ObjectNew: rotatedUnrelated}))
+		assert.False(t, secretEvents.Update(event.UpdateEvent{ObjectOld: secretMetadata, ObjectNew: secretMetadata.DeepCopy()}))
+		rotated := secretMetadata.DeepCopy()
+		rotated.ResourceVersion = "2"
+		assert.True(t, secretEvents.Update(event.UpdateEvent{ObjectOld: secretMetadata, ObjectNew: rotated}))
+		assert.Equal(t, []reconcile.Request{req}, r.mapSecretToPlatformRequests(context.TODO(), rotated))
+
+		secret.Data["POSTGRESQL_PASSWORD"] = []byte("rotated")
+		assert.NoError(t, cl.Update(context.TODO(), secret))
+		_, err = r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: di.GetServiceName(), Namespace: namespace}, dep))
+		assert.NotEmpty(t, dep.Spec.Template.Annotations[metadata.PersistenceSecretChecksum])
+		assert.NotEqual(t, checksum, dep.Spec.Template.Annotations[metadata.PersistenceSecretChecksum])
+	})
+
 	t.Run("verify that a basic reconcile with data index service & jdbcUrl is performed without error", func(t *testing.T) {
 		namespace := t.Name()
 		// Create a SonataFlowPlatform object with metadata and spec.
@@ -827,11 +986,11 @@ func TestSonataFlowPlatformController(t *testing.T) {
 
 		psDi := services.NewDataIndexHandler(ksp)
 		psDi2 := services.NewDataIndexHandler(ksp2)
-		assert.Equal(t, ksp2.Status.ClusterPlatformRef.Services.DataIndexRef.Url, psDi.GetLocalServiceBaseUrl())
+		assert.Equal(t, ksp2.Status.ClusterPlatformRef.Services.DataIndexRef.Url, psDi.GetRemoteServiceBaseUrl())
 		assert.Equal(t, psDi.GetLocalServiceBaseUrl()+constants.KogitoProcessInstancesEventsPath, psDi2.GetServiceBaseUrl()+constants.KogitoProcessInstancesEventsPath)
 		psJs := services.NewJobServiceHandler(ksp)
 		psJs2 := services.NewJobServiceHandler(ksp2)
-		assert.Equal(t, ksp2.Status.ClusterPlatformRef.Services.JobServiceRef.Url, psJs.GetLocalServiceBaseUrl())
+		assert.Equal(t, ksp2.Status.ClusterPlatformRef.Services.JobServiceRef.Url, psJs.GetRemoteServiceBaseUrl())
 		assert.Equal(t, psJs.GetLocalServiceBaseUrl()+constants.JobServiceJobEventsPath, psJs2.GetServiceBaseUrl()+constants.JobServiceJobEventsPath)
 
 		ksp2.Spec.Services = &v1alpha08.ServicesPlatformSpec{}
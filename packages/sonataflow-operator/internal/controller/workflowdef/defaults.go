@@ -0,0 +1,38 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package workflowdef
+
+import (
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// SetDefaults applies the defaults expected by the reconcilers to the given SonataFlow.
+// It's shared by the SonataFlow reconciliation and the mutating admission webhook, so both paths see the same object.
+func SetDefaults(workflow *operatorapi.SonataFlow) {
+	if workflow.Annotations == nil {
+		workflow.Annotations = map[string]string{}
+	}
+	profile := metadata.GetProfileOrDefault(workflow.Annotations)
+	workflow.Annotations[metadata.Profile] = string(profile)
+	if profile == metadata.DevProfile {
+		workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KubernetesDeploymentModel
+	}
+}
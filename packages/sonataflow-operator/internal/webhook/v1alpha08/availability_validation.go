@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// validateAvailability verifies the disruption budget and topology spread shared by the workflows and platform services pod templates.
+func validateAvailability(budget *operatorapi.DisruptionBudgetSpec, spread *operatorapi.TopologySpreadSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if budget != nil {
+		budgetPath := fldPath.Child("disruptionBudget")
+		if budget.MinAvailable != nil && budget.MaxUnavailable != nil {
+			allErrs = append(allErrs, field.Forbidden(budgetPath.Child("maxUnavailable"), "minAvailable and maxUnavailable can't be set at the same time"))
+		}
+		allErrs = append(allErrs, validateIntOrPercent(budget.MinAvailable, budgetPath.Child("minAvailable"))...)
+		allErrs = append(allErrs, validateIntOrPercent(budget.MaxUnavailable, budgetPath.Child("maxUnavailable"))...)
+	}
+	if spread != nil {
+		spreadPath := fldPath.Child("topologySpread")
+		if spread.MaxSkew != nil && *spread.MaxSkew < 1 {
+			allErrs = append(allErrs, field.Invalid(spreadPath.Child("maxSkew"), *spread.MaxSkew, "must be greater than 0"))
+		}
+		for i, topologyKey := range spread.TopologyKeys {
+			if len(topologyKey) == 0 {
+				allErrs = append(allErrs, field.Required(spreadPath.Child("topologyKeys").Index(i), "the topology key must be defined"))
+			}
+		}
+		switch spread.WhenUnsatisfiable {
+		case "", corev1.DoNotSchedule, corev1.ScheduleAnyway:
+		default:
+			allErrs = append(allErrs, field.NotSupported(spreadPath.Child("whenUnsatisfiable"), spread.WhenUnsatisfiable,
+				[]corev1.UnsatisfiableConstraintAction{corev1.DoNotSchedule, corev1.ScheduleAnyway}))
+		}
+	}
+	return allErrs
+}
+
+func validateIntOrPercent(value *intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value.String(), "must be an integer or a percentage, e.g. 1 or 50%")}
+	}
+	if scaled < 0 || (value.Type == intstr.String && scaled > 100) {
+		return field.ErrorList{field.Invalid(fldPath, value.String(), "must be between 0 and 100%, or a non-negative integer")}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/knative"
+)
+
+// validateSourcesDelivery verifies the delivery of the events consumed by the workflow sources.
+func validateSourcesDelivery(sources []operatorapi.SonataFlowSourceSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range sources {
+		allErrs = append(allErrs, validateEventDelivery(sources[i].Delivery, fldPath.Index(i).Child("delivery"))...)
+	}
+	return allErrs
+}
+
+// validateEventDelivery verifies the event delivery the same way Knative Eventing verifies the delivery of the Triggers it's applied to.
+func validateEventDelivery(delivery *operatorapi.EventDeliverySpec, fldPath *field.Path) field.ErrorList {
+	if delivery == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if errs := knative.GetDeliverySpec(delivery, "").Validate(context.Background()); errs != nil {
+		for _, err := range errs.WrappedErrors() {
+			for _, p := range err.Paths {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(p), getDeliveryValue(delivery, p), err.Message))
+			}
+		}
+	}
+	return allErrs
+}
+
+// getDeliveryValue returns the value of the delivery field at the given Knative error path, the errors of the dead
+// letter sink report the whole sink.
+func getDeliveryValue(delivery *operatorapi.EventDeliverySpec, path string) interface{} {
+	switch strings.SplitN(path, ".", 2)[0] {
+	case "retry":
+		if delivery.Retry != nil {
+			return *delivery.Retry
+		}
+	case "backoffPolicy":
+		if delivery.BackoffPolicy != nil {
+			return *delivery.BackoffPolicy
+		}
+	case "backoffDelay":
+		if delivery.BackoffDelay != nil {
+			return *delivery.BackoffDelay
+		}
+	case "deadLetterSink":
+		if delivery.DeadLetterSink != nil {
+			return *delivery.DeadLetterSink
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+var supportedExposureTypes = []operatorapi.ExposureType{operatorapi.IngressExposureType, operatorapi.HTTPRouteExposureType}
+
+// validateWorkflowExposure verifies the workflow exposure, the Knative deployment model exposes the workflow on its own.
+func validateWorkflowExposure(exposure *operatorapi.ExposureSpec, deploymentModel operatorapi.DeploymentModel, fldPath *field.Path) field.ErrorList {
+	if exposure == nil {
+		return nil
+	}
+	if exposure.Disabled {
+		return nil
+	}
+	if deploymentModel == operatorapi.KnativeDeploymentModel {
+		return field.ErrorList{field.Forbidden(fldPath, "knative deployment model workflows are exposed by Knative Serving")}
+	}
+	return validateExposure(exposure, fldPath)
+}
+
+// validatePlatformExposure verifies the platform exposure, a single host can't be shared by every workflow.
+func validatePlatformExposure(exposure *operatorapi.PlatformExposureSpec, fldPath *field.Path) field.ErrorList {
+	if exposure == nil {
+		return nil
+	}
+	allErrs := validateExposure(&exposure.ExposureSpec, fldPath)
+	if exposure.Disabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("disabled"), "only supported in the workflow exposure"))
+	}
+	// the workflows may override the gateway, but the platform defaults must expose them on their own
+	if exposure.Type == operatorapi.HTTPRouteExposureType && exposure.GatewayRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("gatewayRef"), "required by the httpRoute type"))
+	}
+	if len(exposure.Host) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("host"), "the workflows can't share the same host, use domain instead"))
+	}
+	if len(exposure.Domain) > 0 {
+		for _, msg := range validation.IsDNS1123Subdomain(exposure.Domain) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("domain"), exposure.Domain, msg))
+		}
+	}
+	return allErrs
+}
+
+func validateExposure(exposure *operatorapi.ExposureSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch exposure.Type {
+	case "", operatorapi.IngressExposureType, operatorapi.HTTPRouteExposureType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), exposure.Type, supportedExposureTypes))
+	}
+	if len(exposure.Host) > 0 {
+		// wildcard hosts are accepted by both the Ingress and the HTTPRoute
+		for _, msg := range validation.IsDNS1123Subdomain(strings.TrimPrefix(exposure.Host, "*.")) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("host"), exposure.Host, msg))
+		}
+	}
+	if len(exposure.Path) > 0 && !strings.HasPrefix(exposure.Path, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), exposure.Path, "must start with '/'"))
+	}
+	if exposure.GatewayRef != nil && len(exposure.GatewayRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("gatewayRef", "name"), "the gateway name must be defined"))
+	}
+	if exposure.Type == operatorapi.HTTPRouteExposureType && exposure.IngressClassName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ingressClassName"), "only supported by the ingress type"))
+	}
+	return allErrs
+}
@@ -0,0 +1,74 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// validateKafkaEventing verifies the workflow Kafka eventing, it replaces the Knative sink and sources.
+func validateKafkaEventing(workflow *operatorapi.SonataFlow, fldPath *field.Path) field.ErrorList {
+	kafkaEventing := workflow.Spec.Kafka
+	if kafkaEventing == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if workflow.Spec.Sink != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "kafka can't be combined with sink"))
+	}
+	if len(workflow.Spec.Sources) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "kafka can't be combined with sources"))
+	}
+	eventTypes := sets.New[string]()
+	for _, event := range workflow.Spec.Flow.Events {
+		eventTypes.Insert(event.Type)
+	}
+	mapped := sets.New[string]()
+	for i, topic := range kafkaEventing.Topics {
+		topicPath := fldPath.Child("topics").Index(i)
+		if len(topic.EventType) == 0 {
+			allErrs = append(allErrs, field.Required(topicPath.Child("eventType"), "event type must be defined"))
+		} else if mapped.Has(topic.EventType) {
+			allErrs = append(allErrs, field.Duplicate(topicPath.Child("eventType"), topic.EventType))
+		} else if !eventTypes.Has(topic.EventType) {
+			allErrs = append(allErrs, field.NotFound(topicPath.Child("eventType"), topic.EventType))
+		}
+		mapped.Insert(topic.EventType)
+		if len(topic.Topic) == 0 {
+			allErrs = append(allErrs, field.Required(topicPath.Child("topic"), "topic must be defined"))
+		}
+	}
+	if kafkaEventing.Strimzi != nil && len(kafkaEventing.Strimzi.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("strimzi", "clusterName"), "the Strimzi Kafka cluster name must be defined"))
+	}
+	return allErrs
+}
+
+// validatePlatformKafkaEventing verifies the platform Kafka cluster. It's the eventing of every workflow with no sink
+// nor sources, so it can't be combined with the broker those workflows would be expected to use.
+func validatePlatformKafkaEventing(eventing *operatorapi.PlatformEventingSpec, fldPath *field.Path) field.ErrorList {
+	if eventing.Kafka != nil && eventing.Broker != nil {
+		return field.ErrorList{field.Forbidden(fldPath.Child("kafka"), "kafka can't be combined with broker")}
+	}
+	return nil
+}
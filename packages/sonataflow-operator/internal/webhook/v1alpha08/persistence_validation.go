@@ -0,0 +1,147 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+const postgreSQLJdbcUrlPrefix = "jdbc:postgresql:"
+
+var mySQLJdbcUrlPrefixes = []string{"jdbc:mysql:", "jdbc:mariadb:"}
+
+var supportedDBMigrationStrategies = []operatorapi.DBMigrationStrategyType{
+	operatorapi.DBMigrationStrategyService,
+	operatorapi.DBMigrationStrategyJob,
+	operatorapi.DBMigrationStrategyNone,
+}
+
+func validatePersistenceOptions(persistence *operatorapi.PersistenceOptionsSpec, fldPath *field.Path) field.ErrorList {
+	if persistence == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if len(persistence.DBMigrationStrategy) > 0 && !isSupportedDBMigrationStrategy(persistence.DBMigrationStrategy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("dbMigrationStrategy"), persistence.DBMigrationStrategy, supportedDBMigrationStrategies))
+	}
+	if persistence.PostgreSQL != nil {
+		postgreSQLPath := fldPath.Child("postgresql")
+		var serviceRef *operatorapi.SQLServiceOptions
+		if persistence.PostgreSQL.ServiceRef != nil {
+			// the inlined service options are mandatory once a serviceRef is given
+			serviceRef = persistence.PostgreSQL.ServiceRef.SQLServiceOptions
+			if serviceRef == nil {
+				serviceRef = &operatorapi.SQLServiceOptions{}
+			}
+		}
+		allErrs = append(allErrs, validateSQLConnection(persistence.PostgreSQL.SecretRef.Name, serviceRef, persistence.PostgreSQL.JdbcUrl, postgreSQLPath, postgreSQLJdbcUrlPrefix)...)
+	}
+	allErrs = append(allErrs, validateMySQL(persistence.MySQL, persistence.PostgreSQL != nil, fldPath.Child("mysql"))...)
+	return allErrs
+}
+
+// validateMySQL verifies the MySQL spec shared by the workflow, platform and platform services, hasPostgreSQL tells
+// whether the same persistence spec already configures a PostgreSQL database.
+func validateMySQL(mySQL *operatorapi.PersistenceMySQL, hasPostgreSQL bool, fldPath *field.Path) field.ErrorList {
+	if mySQL == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if hasPostgreSQL {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "postgresql and mysql are mutually exclusive"))
+	}
+	return append(allErrs, validateSQLConnection(mySQL.SecretRef.Name, mySQL.ServiceRef, mySQL.JdbcUrl, fldPath, mySQLJdbcUrlPrefixes...)...)
+}
+
+// validateDBMigrationJob rejects the job based migration of a platform service using MySQL, since the DB migrator tool
+// is built for PostgreSQL only. The service persistence takes precedence over the platform one.
+func validateDBMigrationJob(persistence *operatorapi.PersistenceOptionsSpec, platformPersistence *operatorapi.PlatformPersistenceOptionsSpec, fldPath *field.Path) field.ErrorList {
+	if persistence == nil || persistence.DBMigrationStrategy != string(operatorapi.DBMigrationStrategyJob) {
+		return nil
+	}
+	usesMySQL := persistence.MySQL != nil ||
+		(persistence.PostgreSQL == nil && platformPersistence != nil && platformPersistence.PostgreSQL == nil && platformPersistence.MySQL != nil)
+	if usesMySQL {
+		return field.ErrorList{field.Forbidden(fldPath.Child("dbMigrationStrategy"), "the job strategy only supports PostgreSQL databases, use the service strategy with MySQL")}
+	}
+	return nil
+}
+
+// validateManagedPostgreSQL verifies a platform PostgreSQL deployed by the operator, whose secretRef and serviceRef
+// are filled by the operator itself.
+func validateManagedPostgreSQL(postgreSQL *operatorapi.PlatformPersistencePostgreSQL, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(postgreSQL.JdbcUrl) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jdbcUrl"), postgreSQL.JdbcUrl, "jdbcUrl and managed are mutually exclusive"))
+	}
+	if size := postgreSQL.Managed.StorageSize; size != nil && size.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("managed", "storageSize"), size.String(), "must be greater than zero"))
+	}
+	return allErrs
+}
+
+// validateSQLConnection verifies the connection options shared by the workflow, platform and platform services database specs.
+func validateSQLConnection(secretName string, serviceRef *operatorapi.SQLServiceOptions, jdbcUrl string, fldPath *field.Path, jdbcUrlPrefixes ...string) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(secretName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "the database credentials secret name must be defined"))
+	}
+	switch {
+	case serviceRef != nil && len(jdbcUrl) > 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jdbcUrl"), jdbcUrl, "jdbcUrl and serviceRef are mutually exclusive"))
+	case serviceRef == nil && len(jdbcUrl) == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "one of serviceRef or jdbcUrl must be defined"))
+	}
+	if len(jdbcUrl) > 0 && !hasAnyPrefix(jdbcUrl, jdbcUrlPrefixes) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jdbcUrl"), jdbcUrl, "must start with "+strings.Join(jdbcUrlPrefixes, " or ")))
+	}
+	if serviceRef != nil {
+		serviceRefPath := fldPath.Child("serviceRef")
+		if len(serviceRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(serviceRefPath.Child("name"), "the database service name must be defined"))
+		}
+		if serviceRef.Port != nil && (*serviceRef.Port < 1 || *serviceRef.Port > 65535) {
+			allErrs = append(allErrs, field.Invalid(serviceRefPath.Child("port"), *serviceRef.Port, "must be between 1 and 65535"))
+		}
+	}
+	return allErrs
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedDBMigrationStrategy(strategy string) bool {
+	for _, supported := range supportedDBMigrationStrategies {
+		if string(supported) == strategy {
+			return true
+		}
+	}
+	return false
+}
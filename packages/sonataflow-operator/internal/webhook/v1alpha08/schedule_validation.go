@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/profiles/common/schedule"
+)
+
+var supportedConcurrencyPolicies = []operatorapi.ScheduleConcurrencyPolicy{
+	operatorapi.AllowConcurrentSchedule, operatorapi.ForbidConcurrentSchedule, operatorapi.ReplaceConcurrentSchedule,
+}
+
+// validateSchedules verifies the workflow schedules, the generated resource names must fit the CronJob limits.
+func validateSchedules(workflow *operatorapi.SonataFlow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i := range workflow.Spec.Schedules {
+		spec := &workflow.Spec.Schedules[i]
+		schedulePath := fldPath.Index(i)
+		allErrs = append(allErrs, validateUniqueName(spec.Name, names, schedulePath.Child("name"))...)
+		if name := schedule.GetResourceName(workflow, spec); len(spec.Name) > 0 && len(name) > schedule.MaxResourceNameLength {
+			allErrs = append(allErrs, field.TooLong(schedulePath.Child("name"), name, schedule.MaxResourceNameLength))
+		}
+		validTimeZone := true
+		if len(spec.TimeZone) > 0 {
+			if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+				validTimeZone = false
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("timeZone"), spec.TimeZone, "unknown time zone"))
+			}
+		}
+		if len(spec.Cron) == 0 {
+			allErrs = append(allErrs, field.Required(schedulePath.Child("cron"), "cron expression must be defined"))
+		} else if _, err := schedule.Parse(spec); err != nil && validTimeZone {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("cron"), spec.Cron, err.Error()))
+		}
+		if len(spec.Input) > 0 && !json.Valid([]byte(spec.Input)) {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("input"), spec.Input, "must be a valid JSON document"))
+		}
+		switch spec.ConcurrencyPolicy {
+		case "", operatorapi.AllowConcurrentSchedule, operatorapi.ForbidConcurrentSchedule, operatorapi.ReplaceConcurrentSchedule:
+		default:
+			allErrs = append(allErrs, field.NotSupported(schedulePath.Child("concurrencyPolicy"), spec.ConcurrencyPolicy, supportedConcurrencyPolicies))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,491 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	knativeautoscaling "knative.dev/serving/pkg/apis/autoscaling"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/workflowdef"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+// localResourceFunctionTypes are the function types whose operation may point to a file shipped within the workflow resources.
+var localResourceFunctionTypes = sets.New(
+	cncfmodel.FunctionTypeREST,
+	cncfmodel.FunctionTypeAsyncAPI,
+	cncfmodel.FunctionTypeRPC,
+	cncfmodel.FunctionTypeGraphQL,
+	cncfmodel.FunctionTypeOData)
+
+// knativeAutoscalingMetrics are the custom metrics Knative Serving is able to scale on.
+var knativeAutoscalingMetrics = sets.New(knativeautoscaling.Concurrency, knativeautoscaling.RPS)
+
+// SetupSonataFlowWebhookWithManager registers the SonataFlow defaulting and validating webhooks in the manager.
+func SetupSonataFlowWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&operatorapi.SonataFlow{}).
+		WithDefaulter(&SonataFlowCustomDefaulter{}).
+		WithValidator(&SonataFlowCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-sonataflow-org-v1alpha08-sonataflow,mutating=true,failurePolicy=fail,sideEffects=None,groups=sonataflow.org,resources=sonataflows,verbs=create;update,versions=v1alpha08,name=msonataflow-v1alpha08.sonataflow.org,admissionReviewVersions=v1
+
+// SonataFlowCustomDefaulter applies the same defaults the SonataFlow reconciliation relies on.
+type SonataFlowCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &SonataFlowCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter
+func (d *SonataFlowCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	workflow, ok := obj.(*operatorapi.SonataFlow)
+	if !ok {
+		return fmt.Errorf("expected a SonataFlow object but got %T", obj)
+	}
+	klog.V(log.D).InfoS("Applying defaults", "workflow", workflow.Name, "namespace", workflow.Namespace)
+	workflowdef.SetDefaults(workflow)
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-sonataflow-org-v1alpha08-sonataflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=sonataflow.org,resources=sonataflows,verbs=create;update,versions=v1alpha08,name=vsonataflow-v1alpha08.sonataflow.org,admissionReviewVersions=v1
+
+// SonataFlowCustomValidator rejects SonataFlow objects that would otherwise only fail later within the profile reconcilers.
+type SonataFlowCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &SonataFlowCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *SonataFlowCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	workflow, ok := obj.(*operatorapi.SonataFlow)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlow object but got %T", obj)
+	}
+	return v.validate(ctx, workflow)
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *SonataFlowCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	workflow, ok := newObj.(*operatorapi.SonataFlow)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlow object but got %T", newObj)
+	}
+	// updates on a workflow being deleted only remove finalizers and must not be blocked
+	if workflow.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return v.validate(ctx, workflow)
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *SonataFlowCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *SonataFlowCustomValidator) validate(ctx context.Context, workflow *operatorapi.SonataFlow) (admission.Warnings, error) {
+	klog.V(log.D).InfoS("Validating workflow", "workflow", workflow.Name, "namespace", workflow.Namespace)
+	specPath := field.NewPath("spec")
+	allErrs := validateFlow(&workflow.Spec.Flow, specPath.Child("flow"))
+	allErrs = append(allErrs, validatePodTemplate(&workflow.Spec.PodTemplate, specPath.Child("podTemplate"))...)
+	allErrs = append(allErrs, validatePersistenceOptions(workflow.Spec.Persistence, specPath.Child("persistence"))...)
+	allErrs = append(allErrs, validateWorkflowExposure(workflow.Spec.Exposure, workflow.Spec.PodTemplate.DeploymentModel, specPath.Child("exposure"))...)
+	allErrs = append(allErrs, validateSchedules(workflow, specPath.Child("schedules"))...)
+	allErrs = append(allErrs, validateKafkaEventing(workflow, specPath.Child("kafka"))...)
+	allErrs = append(allErrs, validateSourcesDelivery(workflow.Spec.Sources, specPath.Child("sources"))...)
+	warnings, resErrs := v.validateResources(ctx, workflow, specPath)
+	allErrs = append(allErrs, resErrs...)
+	warnings = append(warnings, podTemplateWarnings(&workflow.Spec.PodTemplate)...)
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(operatorapi.GroupVersion.WithKind("SonataFlow").GroupKind(), workflow.Name, allErrs)
+}
+
+// flowReferences holds the names that can be referenced within a flow definition.
+type flowReferences struct {
+	states    sets.Set[string]
+	functions sets.Set[string]
+	events    sets.Set[string]
+}
+
+func validateFlow(flow *operatorapi.Flow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	statesPath := fldPath.Child("states")
+	if len(flow.States) == 0 {
+		return append(allErrs, field.Required(statesPath, "at least one state must be defined"))
+	}
+	refs := flowReferences{states: sets.New[string](), functions: sets.New[string](), events: sets.New[string]()}
+	for i, state := range flow.States {
+		allErrs = append(allErrs, validateUniqueName(state.Name, refs.states, statesPath.Index(i).Child("name"))...)
+		if len(state.Type) == 0 {
+			allErrs = append(allErrs, field.Required(statesPath.Index(i).Child("type"), "state type must be defined"))
+		}
+	}
+	for i, function := range flow.Functions {
+		functionPath := fldPath.Child("functions").Index(i)
+		allErrs = append(allErrs, validateUniqueName(function.Name, refs.functions, functionPath.Child("name"))...)
+		if len(function.Operation) == 0 {
+			allErrs = append(allErrs, field.Required(functionPath.Child("operation"), "function operation must be defined"))
+		}
+	}
+	for i, event := range flow.Events {
+		allErrs = append(allErrs, validateUniqueName(event.Name, refs.events, fldPath.Child("events").Index(i).Child("name"))...)
+	}
+	if flow.Start != nil && len(flow.Start.StateName) > 0 && !refs.states.Has(flow.Start.StateName) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("start"), flow.Start.StateName))
+	}
+	for i := range flow.States {
+		allErrs = append(allErrs, validateStateReferences(&flow.States[i], statesPath.Index(i), &refs)...)
+	}
+	return allErrs
+}
+
+func validateUniqueName(name string, names sets.Set[string], fldPath *field.Path) field.ErrorList {
+	if len(name) == 0 {
+		return field.ErrorList{field.Required(fldPath, "name must be defined")}
+	}
+	if names.Has(name) {
+		return field.ErrorList{field.Duplicate(fldPath, name)}
+	}
+	names.Insert(name)
+	return nil
+}
+
+func validateStateReferences(state *cncfmodel.State, fldPath *field.Path, refs *flowReferences) field.ErrorList {
+	allErrs := validateTransition(state.Transition, fldPath.Child("transition"), refs)
+	if len(state.CompensatedBy) > 0 && !refs.states.Has(state.CompensatedBy) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("compensatedBy"), state.CompensatedBy))
+	}
+	for i, onError := range state.OnErrors {
+		allErrs = append(allErrs, validateTransition(onError.Transition, fldPath.Child("onErrors").Index(i).Child("transition"), refs)...)
+	}
+	switch state.Type {
+	case cncfmodel.StateTypeSwitch:
+		if state.SwitchState == nil {
+			break
+		}
+		for i, condition := range state.DataConditions {
+			allErrs = append(allErrs, validateTransition(condition.Transition, fldPath.Child("dataConditions").Index(i).Child("transition"), refs)...)
+		}
+		for i, condition := range state.EventConditions {
+			conditionPath := fldPath.Child("eventConditions").Index(i)
+			allErrs = append(allErrs, validateEventRef(condition.EventRef, conditionPath.Child("eventRef"), refs)...)
+			allErrs = append(allErrs, validateTransition(condition.Transition, conditionPath.Child("transition"), refs)...)
+		}
+		allErrs = append(allErrs, validateTransition(state.DefaultCondition.Transition, fldPath.Child("defaultCondition").Child("transition"), refs)...)
+	case cncfmodel.StateTypeOperation:
+		if state.OperationState != nil {
+			allErrs = append(allErrs, validateActions(state.OperationState.Actions, fldPath.Child("actions"), refs)...)
+		}
+	case cncfmodel.StateTypeEvent:
+		if state.EventState == nil {
+			break
+		}
+		for i, onEvent := range state.OnEvents {
+			onEventPath := fldPath.Child("onEvents").Index(i)
+			for j, eventRef := range onEvent.EventRefs {
+				allErrs = append(allErrs, validateEventRef(eventRef, onEventPath.Child("eventRefs").Index(j), refs)...)
+			}
+			allErrs = append(allErrs, validateActions(onEvent.Actions, onEventPath.Child("actions"), refs)...)
+		}
+	case cncfmodel.StateTypeForEach:
+		if state.ForEachState != nil {
+			allErrs = append(allErrs, validateActions(state.ForEachState.Actions, fldPath.Child("actions"), refs)...)
+		}
+	case cncfmodel.StateTypeParallel:
+		if state.ParallelState == nil {
+			break
+		}
+		for i, branch := range state.Branches {
+			allErrs = append(allErrs, validateActions(branch.Actions, fldPath.Child("branches").Index(i).Child("actions"), refs)...)
+		}
+	case cncfmodel.StateTypeCallback:
+		if state.CallbackState == nil {
+			break
+		}
+		allErrs = append(allErrs, validateAction(&state.CallbackState.Action, fldPath.Child("action"), refs)...)
+		allErrs = append(allErrs, validateEventRef(state.CallbackState.EventRef, fldPath.Child("eventRef"), refs)...)
+	}
+	return allErrs
+}
+
+func validateTransition(transition *cncfmodel.Transition, fldPath *field.Path, refs *flowReferences) field.ErrorList {
+	if transition == nil || len(transition.NextState) == 0 || refs.states.Has(transition.NextState) {
+		return nil
+	}
+	return field.ErrorList{field.NotFound(fldPath, transition.NextState)}
+}
+
+func validateEventRef(eventRef string, fldPath *field.Path, refs *flowReferences) field.ErrorList {
+	if len(eventRef) == 0 || refs.events.Has(eventRef) {
+		return nil
+	}
+	return field.ErrorList{field.NotFound(fldPath, eventRef)}
+}
+
+func validateActions(actions []cncfmodel.Action, fldPath *field.Path, refs *flowReferences) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range actions {
+		allErrs = append(allErrs, validateAction(&actions[i], fldPath.Index(i), refs)...)
+	}
+	return allErrs
+}
+
+func validateAction(action *cncfmodel.Action, fldPath *field.Path, refs *flowReferences) field.ErrorList {
+	var allErrs field.ErrorList
+	if action.FunctionRef != nil {
+		refPath := fldPath.Child("functionRef", "refName")
+		if len(action.FunctionRef.RefName) == 0 {
+			allErrs = append(allErrs, field.Required(refPath, "must reference a function"))
+		} else if !refs.functions.Has(action.FunctionRef.RefName) {
+			allErrs = append(allErrs, field.NotFound(refPath, action.FunctionRef.RefName))
+		}
+	}
+	if action.EventRef != nil {
+		allErrs = append(allErrs, validateEventRef(action.EventRef.TriggerEventRef, fldPath.Child("eventRef", "triggerEventRef"), refs)...)
+		allErrs = append(allErrs, validateEventRef(action.EventRef.ResultEventRef, fldPath.Child("eventRef", "resultEventRef"), refs)...)
+	}
+	return allErrs
+}
+
+func validatePodTemplate(podTemplate *operatorapi.FlowPodTemplateSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch podTemplate.DeploymentModel {
+	case "", operatorapi.KubernetesDeploymentModel, operatorapi.KnativeDeploymentModel:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("deploymentModel"), podTemplate.DeploymentModel,
+			[]operatorapi.DeploymentModel{operatorapi.KubernetesDeploymentModel, operatorapi.KnativeDeploymentModel}))
+	}
+	if podTemplate.Replicas != nil && *podTemplate.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *podTemplate.Replicas, "must be greater than or equal to 0"))
+	}
+	if podTemplate.Autoscaling != nil {
+		allErrs = append(allErrs, validateAutoscaling(podTemplate.Autoscaling, podTemplate.DeploymentModel, fldPath.Child("autoscaling"))...)
+	}
+	if podTemplate.Rollout != nil {
+		allErrs = append(allErrs, validateRollout(podTemplate.Rollout, podTemplate.DeploymentModel, fldPath.Child("rollout"))...)
+	}
+	allErrs = append(allErrs, validateAvailability(podTemplate.DisruptionBudget, podTemplate.TopologySpread, fldPath)...)
+	return allErrs
+}
+
+func validateAutoscaling(autoscaling *operatorapi.AutoscalingSpec, deploymentModel operatorapi.DeploymentModel, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if autoscaling.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), autoscaling.MaxReplicas, "must be greater than or equal to 1"))
+	}
+	if autoscaling.MinReplicas != nil {
+		if *autoscaling.MinReplicas < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be greater than or equal to 0"))
+		} else if *autoscaling.MinReplicas > autoscaling.MaxReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be less than or equal to maxReplicas"))
+		}
+	}
+	if autoscaling.TargetCPUUtilizationPercentage != nil && *autoscaling.TargetCPUUtilizationPercentage < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetCPUUtilizationPercentage"), *autoscaling.TargetCPUUtilizationPercentage, "must be greater than 0"))
+	}
+	if autoscaling.TargetMemoryUtilizationPercentage != nil && *autoscaling.TargetMemoryUtilizationPercentage < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMemoryUtilizationPercentage"), *autoscaling.TargetMemoryUtilizationPercentage, "must be greater than 0"))
+	}
+	for i, metric := range autoscaling.Metrics {
+		metricPath := fldPath.Child("metrics").Index(i)
+		if len(metric.Name) == 0 {
+			allErrs = append(allErrs, field.Required(metricPath.Child("name"), "the metric name must be defined"))
+		} else if deploymentModel == operatorapi.KnativeDeploymentModel && !knativeAutoscalingMetrics.Has(metric.Name) {
+			allErrs = append(allErrs, field.NotSupported(metricPath.Child("name"), metric.Name, sets.List(knativeAutoscalingMetrics)))
+		}
+		if metric.TargetAverageValue.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(metricPath.Child("targetAverageValue"), metric.TargetAverageValue.String(), "must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+func validateRollout(rollout *operatorapi.RolloutSpec, deploymentModel operatorapi.DeploymentModel, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch rollout.Strategy {
+	case "", operatorapi.BlueGreenRolloutStrategy:
+	case operatorapi.CanaryRolloutStrategy:
+		if deploymentModel != operatorapi.KnativeDeploymentModel {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy"), rollout.Strategy, "the canary strategy requires the knative deployment model"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategy"), rollout.Strategy,
+			[]operatorapi.RolloutStrategy{operatorapi.CanaryRolloutStrategy, operatorapi.BlueGreenRolloutStrategy}))
+	}
+	previous := int32(0)
+	for i, step := range rollout.CanarySteps {
+		if step < 1 || step > 99 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canarySteps").Index(i), step, "must be between 1 and 99"))
+		} else if step <= previous {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canarySteps").Index(i), step, "must be greater than the previous step"))
+		}
+		previous = step
+	}
+	if rollout.StepDuration != nil && rollout.StepDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stepDuration"), rollout.StepDuration.Duration.String(), "must be greater than 0"))
+	}
+	if rollout.ProgressDeadline != nil && rollout.ProgressDeadline.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("progressDeadline"), rollout.ProgressDeadline.Duration.String(), "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func podTemplateWarnings(podTemplate *operatorapi.FlowPodTemplateSpec) admission.Warnings {
+	var warnings admission.Warnings
+	if podTemplate.DeploymentModel == operatorapi.KnativeDeploymentModel && podTemplate.Replicas != nil {
+		warnings = append(warnings, "spec.podTemplate.replicas is ignored in the knative deployment model")
+	} else if podTemplate.Autoscaling != nil && podTemplate.Replicas != nil {
+		warnings = append(warnings, "spec.podTemplate.replicas is ignored when spec.podTemplate.autoscaling is set")
+	}
+	if podTemplate.DeploymentModel == operatorapi.KnativeDeploymentModel && (podTemplate.DisruptionBudget != nil || podTemplate.TopologySpread != nil) {
+		warnings = append(warnings, "spec.podTemplate.disruptionBudget and spec.podTemplate.topologySpread are ignored in the knative deployment model")
+	}
+	if podTemplate.DeploymentModel == operatorapi.KnativeDeploymentModel && podTemplate.Autoscaling != nil {
+		autoscaling := podTemplate.Autoscaling
+		targets := len(autoscaling.Metrics)
+		if autoscaling.TargetCPUUtilizationPercentage != nil {
+			targets++
+		}
+		if autoscaling.TargetMemoryUtilizationPercentage != nil {
+			targets++
+			if podTemplate.Container.Resources.Requests.Memory().IsZero() && autoscaling.TargetCPUUtilizationPercentage == nil {
+				warnings = append(warnings, "spec.podTemplate.autoscaling.targetMemoryUtilizationPercentage requires a memory request in the knative deployment model")
+			}
+		}
+		if targets > 1 {
+			warnings = append(warnings, "Knative scales on a single metric, only the first of the CPU, memory and custom metrics targets is used")
+		}
+	}
+	return warnings
+}
+
+// validateResources verifies that every function operation pointing to a local file can be satisfied by the
+// ConfigMaps declared in spec.resources.configMaps. Missing ConfigMaps are reported as warnings since they can be
+// created after the workflow, in which case the reconciliation waits for them.
+func (v *SonataFlowCustomValidator) validateResources(ctx context.Context, workflow *operatorapi.SonataFlow, specPath *field.Path) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+	configMapsByPath := map[string][]string{}
+	for i, res := range workflow.Spec.Resources.ConfigMaps {
+		if len(res.ConfigMap.Name) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("resources", "configMaps").Index(i).Child("configMap", "name"), "ConfigMap name must be defined"))
+			continue
+		}
+		workflowPath := normalizeWorkflowPath(res.WorkflowPath)
+		configMapsByPath[workflowPath] = append(configMapsByPath[workflowPath], res.ConfigMap.Name)
+	}
+	// In the gitops profile the resources are already part of the workflow image.
+	if metadata.GetProfileOrDefault(workflow.Annotations) == metadata.GitOpsProfile {
+		return warnings, allErrs
+	}
+	configMaps := map[string]*corev1.ConfigMap{}
+	for i, function := range workflow.Spec.Flow.Functions {
+		file, ok := localResourceFile(&function)
+		if !ok {
+			continue
+		}
+		operationPath := specPath.Child("flow", "functions").Index(i).Child("operation")
+		workflowPath, fileName := normalizeWorkflowPath(path.Dir(file)), path.Base(file)
+		names := configMapsByPath[workflowPath]
+		if len(names) == 0 {
+			allErrs = append(allErrs, field.Invalid(operationPath, function.Operation,
+				fmt.Sprintf("no ConfigMap in spec.resources.configMaps is mounted at workflowPath %q", workflowPath)))
+			continue
+		}
+		found, complete := false, true
+		for _, name := range names {
+			cm, err := v.getConfigMap(ctx, configMaps, name, workflow.Namespace)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					return warnings, append(allErrs, field.InternalError(operationPath, err))
+				}
+				warnings = append(warnings, fmt.Sprintf("ConfigMap %s referenced in spec.resources.configMaps not found in namespace %s", name, workflow.Namespace))
+				complete = false
+				continue
+			}
+			if _, ok := cm.Data[fileName]; ok {
+				found = true
+			} else if _, ok := cm.BinaryData[fileName]; ok {
+				found = true
+			}
+		}
+		if !found && complete {
+			allErrs = append(allErrs, field.Invalid(operationPath, function.Operation,
+				fmt.Sprintf("file %q not found in ConfigMaps %s", fileName, strings.Join(names, ", "))))
+		}
+	}
+	return warnings, allErrs
+}
+
+func (v *SonataFlowCustomValidator) getConfigMap(ctx context.Context, cache map[string]*corev1.ConfigMap, name, namespace string) (*corev1.ConfigMap, error) {
+	if cm, ok := cache[name]; ok {
+		return cm, nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, cm); err != nil {
+		return nil, err
+	}
+	cache[name] = cm
+	return cm, nil
+}
+
+// localResourceFile returns the file referenced by the function operation if it's expected to be found within the
+// workflow resources, e.g. `specs/openapi.yaml#getPets`. Remote URLs, classpath references and absolute paths are ignored.
+func localResourceFile(function *cncfmodel.Function) (string, bool) {
+	if !localResourceFunctionTypes.Has(function.Type) {
+		return "", false
+	}
+	file := strings.TrimSpace(strings.SplitN(function.Operation, "#", 2)[0])
+	if len(file) == 0 || path.IsAbs(file) {
+		return "", false
+	}
+	if u, err := url.Parse(file); err != nil || len(u.Scheme) > 0 {
+		return "", false
+	}
+	return file, true
+}
+
+// normalizeWorkflowPath follows the same rules the operator uses to mount the resources: leading slashes are removed
+// and the root of the resources directory is represented as ".".
+func normalizeWorkflowPath(workflowPath string) string {
+	return path.Clean(strings.TrimLeft(workflowPath, "/"))
+}
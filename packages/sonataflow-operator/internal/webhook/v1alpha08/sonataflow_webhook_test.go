@@ -0,0 +1,400 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestSonataFlowCustomDefaulter_Default(t *testing.T) {
+	t.Run("defaults the profile annotation", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		workflow.Annotations = nil
+		assert.NoError(t, (&SonataFlowCustomDefaulter{}).Default(context.TODO(), workflow))
+		assert.Equal(t, metadata.PreviewProfile.String(), workflow.Annotations[metadata.Profile])
+	})
+	t.Run("forces the kubernetes deployment model in dev profile", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+		workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+		assert.NoError(t, (&SonataFlowCustomDefaulter{}).Default(context.TODO(), workflow))
+		assert.Equal(t, operatorapi.KubernetesDeploymentModel, workflow.Spec.PodTemplate.DeploymentModel)
+	})
+	t.Run("rejects other kinds", func(t *testing.T) {
+		assert.Error(t, (&SonataFlowCustomDefaulter{}).Default(context.TODO(), &operatorapi.SonataFlowPlatform{}))
+	})
+}
+
+func TestSonataFlowCustomValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name          string
+		mutate        func(workflow *operatorapi.SonataFlow)
+		expectedField string
+	}{
+		{
+			name: "no states",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States = nil
+			},
+			expectedField: "spec.flow.states",
+		},
+		{
+			name: "duplicated state",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States[1].Name = workflow.Spec.Flow.States[2].Name
+			},
+			expectedField: "spec.flow.states[2].name",
+		},
+		{
+			name: "start state not found",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.Start.StateName = "NotAState"
+			},
+			expectedField: "spec.flow.start",
+		},
+		{
+			name: "transition to a missing state",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States[1].Transition.NextState = "NotAState"
+			},
+			expectedField: "spec.flow.states[1].transition",
+		},
+		{
+			name: "switch condition to a missing state",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States[0].DataConditions[1].Transition.NextState = "NotAState"
+			},
+			expectedField: "spec.flow.states[0].dataConditions[1].transition",
+		},
+		{
+			name: "action referencing a missing function",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States[3].OperationState.Actions[0].FunctionRef.RefName = "notAFunction"
+			},
+			expectedField: "spec.flow.states[3].actions[0].functionRef.refName",
+		},
+		{
+			name: "action with an empty function reference",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.States[3].OperationState.Actions[0].FunctionRef.RefName = ""
+			},
+			expectedField: "spec.flow.states[3].actions[0].functionRef.refName: Required value",
+		},
+		{
+			name: "unknown db migration strategy",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Persistence = &operatorapi.PersistenceOptionsSpec{DBMigrationStrategy: "flyway"}
+			},
+			expectedField: "spec.persistence.dbMigrationStrategy",
+		},
+		{
+			name: "postgresql with jdbcUrl and serviceRef",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Persistence = &operatorapi.PersistenceOptionsSpec{
+					PostgreSQL: &operatorapi.PersistencePostgreSQL{
+						SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "db-secret"},
+						ServiceRef: &operatorapi.PostgreSQLServiceOptions{SQLServiceOptions: &operatorapi.SQLServiceOptions{Name: "db"}},
+						JdbcUrl:    "jdbc:postgresql://db:5432/sonataflow",
+					},
+				}
+			},
+			expectedField: "spec.persistence.postgresql.jdbcUrl",
+		},
+		{
+			name: "unknown deployment model",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.DeploymentModel = "openshift"
+			},
+			expectedField: "spec.podTemplate.deploymentModel",
+		},
+		{
+			name: "negative replicas",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.Replicas = pointer.Int32(-1)
+			},
+			expectedField: "spec.podTemplate.replicas",
+		},
+		{
+			name: "canary rollout in the kubernetes deployment model",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.Rollout = &operatorapi.RolloutSpec{Strategy: operatorapi.CanaryRolloutStrategy}
+			},
+			expectedField: "spec.podTemplate.rollout.strategy",
+		},
+		{
+			name: "canary steps not increasing",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+				workflow.Spec.PodTemplate.Rollout = &operatorapi.RolloutSpec{Strategy: operatorapi.CanaryRolloutStrategy, CanarySteps: []int32{50, 20}}
+			},
+			expectedField: "spec.podTemplate.rollout.canarySteps[1]",
+		},
+		{
+			name: "autoscaling min replicas greater than max replicas",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.Autoscaling = &operatorapi.AutoscalingSpec{MinReplicas: pointer.Int32(5), MaxReplicas: 2}
+			},
+			expectedField: "spec.podTemplate.autoscaling.minReplicas",
+		},
+		{
+			name: "autoscaling metric not supported by knative",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+				workflow.Spec.PodTemplate.Autoscaling = &operatorapi.AutoscalingSpec{
+					MaxReplicas: 3,
+					Metrics:     []operatorapi.AutoscalingMetricSpec{{Name: "http_requests", TargetAverageValue: resource.MustParse("10")}},
+				}
+			},
+			expectedField: "spec.podTemplate.autoscaling.metrics[0].name",
+		},
+		{
+			name: "disruption budget with min available and max unavailable",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				minAvailable, maxUnavailable := intstr.FromInt32(1), intstr.FromInt32(1)
+				workflow.Spec.PodTemplate.DisruptionBudget = &operatorapi.DisruptionBudgetSpec{MinAvailable: &minAvailable, MaxUnavailable: &maxUnavailable}
+			},
+			expectedField: "spec.podTemplate.disruptionBudget.maxUnavailable",
+		},
+		{
+			name: "topology spread with an invalid max skew",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.TopologySpread = &operatorapi.TopologySpreadSpec{MaxSkew: pointer.Int32(0)}
+			},
+			expectedField: "spec.podTemplate.topologySpread.maxSkew",
+		},
+		{
+			name: "exposure in the knative deployment model",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+				workflow.Spec.Exposure = &operatorapi.ExposureSpec{Host: "greeting.example.com"}
+			},
+			expectedField: "spec.exposure",
+		},
+		{
+			name: "exposure with an invalid host",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Exposure = &operatorapi.ExposureSpec{Host: "Greeting_Example"}
+			},
+			expectedField: "spec.exposure.host",
+		},
+		{
+			name: "http route exposure with an ingress class",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Exposure = &operatorapi.ExposureSpec{Type: operatorapi.HTTPRouteExposureType, IngressClassName: pointer.String("nginx")}
+			},
+			expectedField: "spec.exposure.ingressClassName",
+		},
+		{
+			name: "duplicated schedule",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Schedules = []operatorapi.ScheduleSpec{{Name: "nightly", Cron: "0 2 * * *"}, {Name: "nightly", Cron: "0 3 * * *"}}
+			},
+			expectedField: "spec.schedules[1].name",
+		},
+		{
+			name: "schedule with an invalid cron expression",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Schedules = []operatorapi.ScheduleSpec{{Name: "nightly", Cron: "0 2 * *"}}
+			},
+			expectedField: "spec.schedules[0].cron",
+		},
+		{
+			name: "schedule with an unknown time zone",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Schedules = []operatorapi.ScheduleSpec{{Name: "nightly", Cron: "0 2 * * *", TimeZone: "Mars/Olympus"}}
+			},
+			expectedField: "spec.schedules[0].timeZone",
+		},
+		{
+			name: "schedule with an invalid input",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Schedules = []operatorapi.ScheduleSpec{{Name: "nightly", Cron: "0 2 * * *", Input: "{name: Ada"}}
+			},
+			expectedField: "spec.schedules[0].input",
+		},
+		{
+			name: "schedule name too long for the workflow",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Name = "a-workflow-with-a-rather-long-name"
+				workflow.Spec.Schedules = []operatorapi.ScheduleSpec{{Name: "every-night-at-two", Cron: "0 2 * * *"}}
+			},
+			expectedField: "spec.schedules[0].name",
+		},
+		{
+			name: "kafka eventing with a sink",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Kafka = &operatorapi.KafkaEventingSpec{}
+				workflow.Spec.Sink = &duckv1.Destination{URI: apis.HTTP("sink.example.com")}
+			},
+			expectedField: "spec.kafka",
+		},
+		{
+			name: "kafka topic of an unknown event type",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Kafka = &operatorapi.KafkaEventingSpec{Topics: []operatorapi.KafkaTopicSpec{{EventType: "org.acme.unknown", Topic: "unknown"}}}
+			},
+			expectedField: "spec.kafka.topics[0].eventType",
+		},
+		{
+			name: "kafka strimzi topics without cluster",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Kafka = &operatorapi.KafkaEventingSpec{KafkaClusterSpec: operatorapi.KafkaClusterSpec{Strimzi: &operatorapi.StrimziTopicsSpec{}}}
+			},
+			expectedField: "spec.kafka.strimzi.clusterName",
+		},
+		{
+			name: "source delivery with negative retry",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Sources = []operatorapi.SonataFlowSourceSpec{{
+					EventType:   "org.acme.event",
+					Destination: duckv1.Destination{URI: apis.HTTP("broker.example.com")},
+					Delivery:    &operatorapi.EventDeliverySpec{Retry: pointer.Int32(-1)},
+				}}
+			},
+			expectedField: "spec.sources[0].delivery.retry: Invalid value: -1",
+		},
+		{
+			name: "source delivery with invalid backoff delay",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Sources = []operatorapi.SonataFlowSourceSpec{{
+					EventType:   "org.acme.event",
+					Destination: duckv1.Destination{URI: apis.HTTP("broker.example.com")},
+					Delivery:    &operatorapi.EventDeliverySpec{BackoffDelay: pointer.String("1s")},
+				}}
+			},
+			expectedField: "spec.sources[0].delivery.backoffDelay: Invalid value: \"1s\"",
+		},
+		{
+			name: "function operation without resources",
+			mutate: func(workflow *operatorapi.SonataFlow) {
+				workflow.Spec.Flow.Functions = append(workflow.Spec.Flow.Functions,
+					cncfmodel.Function{Name: "getPets", Operation: "specs/petstore.yaml#getPets", Type: cncfmodel.FunctionTypeREST})
+			},
+			expectedField: "spec.flow.functions[1].operation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow := test.GetBaseSonataFlow(t.Name())
+			tt.mutate(workflow)
+			validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+			_, err := validator.ValidateCreate(context.TODO(), workflow)
+			assert.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tt.expectedField)
+		})
+	}
+}
+
+func TestSonataFlowCustomValidator_ValidateResources(t *testing.T) {
+	newWorkflow := func(namespace string) *operatorapi.SonataFlow {
+		workflow := test.GetBaseSonataFlow(namespace)
+		workflow.Spec.Flow.Functions = append(workflow.Spec.Flow.Functions,
+			cncfmodel.Function{Name: "getPets", Operation: "specs/petstore.yaml#getPets", Type: cncfmodel.FunctionTypeREST})
+		workflow.Spec.Resources.ConfigMaps = []operatorapi.ConfigMapWorkflowResource{
+			{ConfigMap: corev1.LocalObjectReference{Name: "petstore-specs"}, WorkflowPath: "/specs"},
+		}
+		return workflow
+	}
+	newConfigMap := func(namespace string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "petstore-specs", Namespace: namespace}, Data: data}
+	}
+
+	t.Run("file found in the resource ConfigMap", func(t *testing.T) {
+		workflow := newWorkflow(t.Name())
+		cm := newConfigMap(t.Name(), map[string]string{"petstore.yaml": "openapi: 3.0.0"})
+		validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(cm).Build()}
+		warnings, err := validator.ValidateCreate(context.TODO(), workflow)
+		assert.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+	t.Run("file missing in the resource ConfigMap", func(t *testing.T) {
+		workflow := newWorkflow(t.Name())
+		cm := newConfigMap(t.Name(), map[string]string{"other.yaml": "openapi: 3.0.0"})
+		validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(cm).Build()}
+		_, err := validator.ValidateCreate(context.TODO(), workflow)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "spec.flow.functions[1].operation")
+	})
+	t.Run("resource ConfigMap not created yet", func(t *testing.T) {
+		workflow := newWorkflow(t.Name())
+		validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+		warnings, err := validator.ValidateCreate(context.TODO(), workflow)
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+	})
+	t.Run("remote operations are ignored", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		workflow.Spec.Flow.Functions = append(workflow.Spec.Flow.Functions,
+			cncfmodel.Function{Name: "getPets", Operation: "https://petstore.example.com/openapi.yaml#getPets", Type: cncfmodel.FunctionTypeREST})
+		validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+		_, err := validator.ValidateCreate(context.TODO(), workflow)
+		assert.NoError(t, err)
+	})
+	t.Run("gitops workflows ship their own resources", func(t *testing.T) {
+		workflow := newWorkflow(t.Name())
+		workflow.Spec.Resources.ConfigMaps = nil
+		test.SetGitopsProfile(workflow)
+		validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+		_, err := validator.ValidateCreate(context.TODO(), workflow)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSonataFlowCustomValidator_ValidateUpdate(t *testing.T) {
+	oldWorkflow := test.GetBaseSonataFlow(t.Name())
+	validator := &SonataFlowCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+
+	newWorkflow := oldWorkflow.DeepCopy()
+	_, err := validator.ValidateUpdate(context.TODO(), oldWorkflow, newWorkflow)
+	assert.NoError(t, err)
+
+	newWorkflow.Spec.PodTemplate.DeploymentModel = operatorapi.KnativeDeploymentModel
+	newWorkflow.Spec.PodTemplate.Replicas = pointer.Int32(2)
+	warnings, err := validator.ValidateUpdate(context.TODO(), oldWorkflow, newWorkflow)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+
+	_, err = validator.ValidateUpdate(context.TODO(), oldWorkflow, runtime.Object(&operatorapi.SonataFlowBuild{}))
+	assert.Error(t, err)
+
+	deletedWorkflow := oldWorkflow.DeepCopy()
+	deletedWorkflow.Spec.Flow.States = nil
+	deletedWorkflow.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	_, err = validator.ValidateUpdate(context.TODO(), oldWorkflow, deletedWorkflow)
+	assert.NoError(t, err)
+}
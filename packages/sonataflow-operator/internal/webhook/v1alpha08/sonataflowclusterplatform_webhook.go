@@ -0,0 +1,122 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/clusterplatform"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+// SetupSonataFlowClusterPlatformWebhookWithManager registers the SonataFlowClusterPlatform validating webhook in the manager.
+func SetupSonataFlowClusterPlatformWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&operatorapi.SonataFlowClusterPlatform{}).
+		WithValidator(&SonataFlowClusterPlatformCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-sonataflow-org-v1alpha08-sonataflowclusterplatform,mutating=false,failurePolicy=fail,sideEffects=None,groups=sonataflow.org,resources=sonataflowclusterplatforms,verbs=create;update,versions=v1alpha08,name=vsonataflowclusterplatform-v1alpha08.sonataflow.org,admissionReviewVersions=v1
+
+// SonataFlowClusterPlatformCustomValidator rejects SonataFlowClusterPlatform objects that would be marked as duplicated.
+type SonataFlowClusterPlatformCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &SonataFlowClusterPlatformCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *SonataFlowClusterPlatformCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cPlatform, ok := obj.(*operatorapi.SonataFlowClusterPlatform)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlowClusterPlatform object but got %T", obj)
+	}
+	allErrs, err := v.validateUniqueness(ctx, cPlatform)
+	if err != nil {
+		return nil, err
+	}
+	return nil, toClusterPlatformInvalidError(cPlatform, append(allErrs, validateClusterPlatformSpec(&cPlatform.Spec, field.NewPath("spec"))...))
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *SonataFlowClusterPlatformCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	cPlatform, ok := newObj.(*operatorapi.SonataFlowClusterPlatform)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlowClusterPlatform object but got %T", newObj)
+	}
+	return nil, toClusterPlatformInvalidError(cPlatform, validateClusterPlatformSpec(&cPlatform.Spec, field.NewPath("spec")))
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *SonataFlowClusterPlatformCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateUniqueness refuses a primary cluster platform when there is already another one in the cluster. Every primary
+// cluster platform not reported as duplicated counts, the first one may not be ready yet when the second is created.
+func (v *SonataFlowClusterPlatformCustomValidator) validateUniqueness(ctx context.Context, cPlatform *operatorapi.SonataFlowClusterPlatform) (field.ErrorList, error) {
+	if clusterplatform.IsSecondary(cPlatform) {
+		return nil, nil
+	}
+	cPlatforms := &operatorapi.SonataFlowClusterPlatformList{}
+	if err := v.Client.List(ctx, cPlatforms); err != nil {
+		return nil, err
+	}
+	for i := range cPlatforms.Items {
+		p := &cPlatforms.Items[i]
+		if p.Name != cPlatform.Name && !clusterplatform.IsSecondary(p) && !p.Status.IsDuplicated() {
+			klog.V(log.D).InfoS("Refusing duplicated cluster platform", "clusterPlatform", cPlatform.Name, "active", p.Name)
+			return field.ErrorList{field.Forbidden(field.NewPath("metadata", "name"),
+				fmt.Sprintf("the cluster already has the active SonataFlowClusterPlatform %s", p.Name))}, nil
+		}
+	}
+	return nil, nil
+}
+
+func validateClusterPlatformSpec(spec *operatorapi.SonataFlowClusterPlatformSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	platformRefPath := fldPath.Child("platformRef")
+	if len(spec.PlatformRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(platformRefPath.Child("name"), "the referenced SonataFlowPlatform name must be defined"))
+	}
+	if len(spec.PlatformRef.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(platformRefPath.Child("namespace"), "the referenced SonataFlowPlatform namespace must be defined"))
+	}
+	return allErrs
+}
+
+func toClusterPlatformInvalidError(cPlatform *operatorapi.SonataFlowClusterPlatform, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(operatorapi.GroupVersion.WithKind(operatorapi.SonataFlowClusterPlatformKind).GroupKind(), cPlatform.Name, allErrs)
+}
@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestSonataFlowClusterPlatformCustomValidator(t *testing.T) {
+	active := test.GetBaseClusterPlatformInReadyPhase(t.Name())
+	validator := &SonataFlowClusterPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(active).Build()}
+	newClusterPlatform := func(name string) *operatorapi.SonataFlowClusterPlatform {
+		cPlatform := test.GetBaseClusterPlatformInReadyPhase(t.Name())
+		cPlatform.ObjectMeta = metav1.ObjectMeta{Name: name}
+		cPlatform.Status = operatorapi.SonataFlowClusterPlatformStatus{}
+		return cPlatform
+	}
+
+	t.Run("second cluster platform is refused", func(t *testing.T) {
+		_, err := validator.ValidateCreate(context.TODO(), newClusterPlatform("another-cluster"))
+		assert.Error(t, err)
+		assert.True(t, apierrors.IsInvalid(err))
+		assert.Contains(t, err.Error(), "metadata.name: Forbidden")
+	})
+	t.Run("second cluster platform is refused while the first isn't ready", func(t *testing.T) {
+		pending := newClusterPlatform("pending-cluster")
+		pendingValidator := &SonataFlowClusterPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(pending).Build()}
+		_, err := pendingValidator.ValidateCreate(context.TODO(), newClusterPlatform("another-cluster"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "metadata.name: Forbidden")
+	})
+	t.Run("duplicated cluster platforms are ignored", func(t *testing.T) {
+		duplicated := newClusterPlatform("duplicated-cluster")
+		duplicated.Status.Manager().MarkFalse(api.SucceedConditionType, operatorapi.PlatformDuplicatedReason, "")
+		duplicatedValidator := &SonataFlowClusterPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(duplicated).Build()}
+		_, err := duplicatedValidator.ValidateCreate(context.TODO(), newClusterPlatform("another-cluster"))
+		assert.NoError(t, err)
+	})
+	t.Run("secondary cluster platform is allowed", func(t *testing.T) {
+		cPlatform := newClusterPlatform("secondary-cluster")
+		cPlatform.Annotations = map[string]string{metadata.SecondaryPlatformAnnotation: "true"}
+		_, err := validator.ValidateCreate(context.TODO(), cPlatform)
+		assert.NoError(t, err)
+	})
+	t.Run("platformRef is required", func(t *testing.T) {
+		cPlatform := newClusterPlatform("cluster")
+		cPlatform.Spec.PlatformRef = operatorapi.SonataFlowPlatformRef{}
+		_, err := validator.ValidateUpdate(context.TODO(), cPlatform, cPlatform)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "spec.platformRef.name")
+		assert.Contains(t, err.Error(), "spec.platformRef.namespace")
+	})
+}
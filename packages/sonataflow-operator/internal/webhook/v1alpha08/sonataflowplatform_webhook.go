@@ -0,0 +1,242 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	containerbuilder "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/container-builder/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/internal/controller/platform"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/log"
+)
+
+var supportedBuildStrategies = []operatorapi.BuildStrategy{
+	operatorapi.OperatorBuildStrategy,
+	operatorapi.PlatformBuildStrategy,
+	operatorapi.TektonBuildStrategy,
+}
+
+// SetupSonataFlowPlatformWebhookWithManager registers the SonataFlowPlatform validating webhook in the manager.
+func SetupSonataFlowPlatformWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&operatorapi.SonataFlowPlatform{}).
+		WithValidator(&SonataFlowPlatformCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-sonataflow-org-v1alpha08-sonataflowplatform,mutating=false,failurePolicy=fail,sideEffects=None,groups=sonataflow.org,resources=sonataflowplatforms,verbs=create;update,versions=v1alpha08,name=vsonataflowplatform-v1alpha08.sonataflow.org,admissionReviewVersions=v1
+
+// SonataFlowPlatformCustomValidator rejects SonataFlowPlatform objects that would be marked as duplicated
+// or fail while the platform and its services are being configured.
+type SonataFlowPlatformCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &SonataFlowPlatformCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *SonataFlowPlatformCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	plat, ok := obj.(*operatorapi.SonataFlowPlatform)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlowPlatform object but got %T", obj)
+	}
+	allErrs, err := v.validateUniqueness(ctx, plat)
+	if err != nil {
+		return nil, err
+	}
+	return nil, toPlatformInvalidError(plat, append(allErrs, validatePlatformSpec(&plat.Spec, field.NewPath("spec"))...))
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *SonataFlowPlatformCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	plat, ok := newObj.(*operatorapi.SonataFlowPlatform)
+	if !ok {
+		return nil, fmt.Errorf("expected a SonataFlowPlatform object but got %T", newObj)
+	}
+	// Duplicated platforms already admitted must remain editable, so that users can mark them as secondary.
+	return nil, toPlatformInvalidError(plat, validatePlatformSpec(&plat.Spec, field.NewPath("spec")))
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *SonataFlowPlatformCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateUniqueness refuses a primary platform in a namespace that already holds an active one.
+func (v *SonataFlowPlatformCustomValidator) validateUniqueness(ctx context.Context, plat *operatorapi.SonataFlowPlatform) (field.ErrorList, error) {
+	if platform.IsSecondary(plat) {
+		return nil, nil
+	}
+	platforms := &operatorapi.SonataFlowPlatformList{}
+	if err := v.Client.List(ctx, platforms, client.InNamespace(plat.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range platforms.Items {
+		p := &platforms.Items[i]
+		if p.Name != plat.Name && !platform.IsSecondary(p) && platform.IsActive(p) {
+			klog.V(log.D).InfoS("Refusing duplicated platform", "platform", plat.Name, "active", p.Name, "namespace", plat.Namespace)
+			return field.ErrorList{field.Forbidden(field.NewPath("metadata", "name"),
+				fmt.Sprintf("namespace %s already has the active SonataFlowPlatform %s", plat.Namespace, p.Name))}, nil
+		}
+	}
+	return nil, nil
+}
+
+func validatePlatformSpec(spec *operatorapi.SonataFlowPlatformSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := validateBuildPlatformConfig(&spec.Build.Config, fldPath.Child("build", "config"))
+	if spec.Persistence != nil {
+		if postgreSQL := spec.Persistence.PostgreSQL; postgreSQL != nil && postgreSQL.Managed != nil {
+			allErrs = append(allErrs, validateManagedPostgreSQL(postgreSQL, fldPath.Child("persistence", "postgresql"))...)
+		} else if postgreSQL != nil {
+			allErrs = append(allErrs, validateSQLConnection(postgreSQL.SecretRef.Name, postgreSQL.ServiceRef, postgreSQL.JdbcUrl, fldPath.Child("persistence", "postgresql"), postgreSQLJdbcUrlPrefix)...)
+		}
+		allErrs = append(allErrs, validateMySQL(spec.Persistence.MySQL, spec.Persistence.PostgreSQL != nil, fldPath.Child("persistence", "mysql"))...)
+	}
+	if spec.Services != nil {
+		servicesPath := fldPath.Child("services")
+		if spec.Services.DataIndex != nil {
+			allErrs = append(allErrs, validatePersistenceOptions(spec.Services.DataIndex.Persistence, servicesPath.Child("dataIndex", "persistence"))...)
+			allErrs = append(allErrs, validateDBMigrationJob(spec.Services.DataIndex.Persistence, spec.Persistence, servicesPath.Child("dataIndex", "persistence"))...)
+			podTemplate := spec.Services.DataIndex.PodTemplate
+			allErrs = append(allErrs, validateAvailability(podTemplate.DisruptionBudget, podTemplate.TopologySpread, servicesPath.Child("dataIndex", "podTemplate"))...)
+		}
+		if spec.Services.JobService != nil {
+			allErrs = append(allErrs, validatePersistenceOptions(spec.Services.JobService.Persistence, servicesPath.Child("jobService", "persistence"))...)
+			allErrs = append(allErrs, validateDBMigrationJob(spec.Services.JobService.Persistence, spec.Persistence, servicesPath.Child("jobService", "persistence"))...)
+			podTemplate := spec.Services.JobService.PodTemplate
+			allErrs = append(allErrs, validateAvailability(podTemplate.DisruptionBudget, podTemplate.TopologySpread, servicesPath.Child("jobService", "podTemplate"))...)
+		}
+	}
+	if spec.Properties != nil {
+		allErrs = append(allErrs, validatePropertyVars(spec.Properties.Flow, fldPath.Child("properties", "flow"))...)
+	}
+	allErrs = append(allErrs, validatePlatformExposure(spec.Exposure, fldPath.Child("exposure"))...)
+	allErrs = append(allErrs, validatePlatformTLS(spec.TLS, fldPath.Child("tls"))...)
+	if spec.Eventing != nil {
+		allErrs = append(allErrs, validateEventDelivery(spec.Eventing.Delivery, fldPath.Child("eventing", "delivery"))...)
+		allErrs = append(allErrs, validatePlatformKafkaEventing(spec.Eventing, fldPath.Child("eventing"))...)
+	}
+	return allErrs
+}
+
+func validateBuildPlatformConfig(config *operatorapi.BuildPlatformConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(config.BuildStrategy) > 0 && !isSupportedBuildStrategy(config.BuildStrategy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategy"), config.BuildStrategy, supportedBuildStrategies))
+	}
+	if builder, ok := config.BuildStrategyOptions[platform.ContainerBuilderOption]; ok && !slices.Contains(platform.SupportedContainerBuilders, containerbuilder.PlatformContainerBuildPublishStrategy(builder)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategyOptions").Key(platform.ContainerBuilderOption), builder, platform.SupportedContainerBuilders))
+	}
+	if config.Timeout != nil && config.Timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), config.Timeout.Duration.String(), "must not be negative"))
+	}
+	registryPath := fldPath.Child("registry")
+	if address := config.Registry.Address; len(address) > 0 {
+		// the address is used as the image name prefix, so it can't carry a scheme
+		if strings.Contains(address, "://") {
+			allErrs = append(allErrs, field.Invalid(registryPath.Child("address"), address, "must not contain a scheme, e.g. quay.io or registry:5000"))
+		} else if u, err := url.Parse("//" + address); err != nil || len(u.Host) == 0 || len(u.RawQuery) > 0 || len(u.Fragment) > 0 {
+			allErrs = append(allErrs, field.Invalid(registryPath.Child("address"), address, "must be a registry host with an optional port and path"))
+		}
+	}
+	if organization := config.Registry.Organization; strings.ContainsAny(organization, ": ") {
+		allErrs = append(allErrs, field.Invalid(registryPath.Child("organization"), organization, "must be a valid image repository path"))
+	}
+	if signing := config.Signing; signing != nil && len(signing.KeySecret) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("signing", "keySecret"), "the secret holding the cosign key must be defined"))
+	}
+	return allErrs
+}
+
+// validatePropertyVars verifies the platform managed properties the same way the API server verifies container env vars.
+func validatePropertyVars(properties []operatorapi.PropertyVar, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i, property := range properties {
+		propertyPath := fldPath.Index(i)
+		if len(property.Name) == 0 {
+			allErrs = append(allErrs, field.Required(propertyPath.Child("name"), "the property name must be defined"))
+		} else if names.Has(property.Name) {
+			allErrs = append(allErrs, field.Duplicate(propertyPath.Child("name"), property.Name))
+		}
+		names.Insert(property.Name)
+		if property.ValueFrom == nil {
+			continue
+		}
+		valueFromPath := propertyPath.Child("valueFrom")
+		if len(property.Value) > 0 {
+			allErrs = append(allErrs, field.Invalid(valueFromPath, "", "may not be specified when `value` is not empty"))
+		}
+		source := property.ValueFrom
+		switch {
+		case source.ConfigMapKeyRef != nil && source.SecretKeyRef != nil:
+			allErrs = append(allErrs, field.Invalid(valueFromPath, "", "may not have more than one field specified at a time"))
+		case source.ConfigMapKeyRef != nil:
+			allErrs = append(allErrs, validateKeySelector(source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key, valueFromPath.Child("configMapKeyRef"))...)
+		case source.SecretKeyRef != nil:
+			allErrs = append(allErrs, validateKeySelector(source.SecretKeyRef.Name, source.SecretKeyRef.Key, valueFromPath.Child("secretKeyRef"))...)
+		default:
+			allErrs = append(allErrs, field.Required(valueFromPath, "one of configMapKeyRef or secretKeyRef must be defined"))
+		}
+	}
+	return allErrs
+}
+
+func validateKeySelector(name, key string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if len(key) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), ""))
+	}
+	return allErrs
+}
+
+func isSupportedBuildStrategy(strategy operatorapi.BuildStrategy) bool {
+	for _, supported := range supportedBuildStrategies {
+		if supported == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+func toPlatformInvalidError(plat *operatorapi.SonataFlowPlatform, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(operatorapi.GroupVersion.WithKind(operatorapi.SonataFlowPlatformKind).GroupKind(), plat.Name, allErrs)
+}
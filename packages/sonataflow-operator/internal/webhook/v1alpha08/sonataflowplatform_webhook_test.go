@@ -0,0 +1,357 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+func TestSonataFlowPlatformCustomValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name          string
+		mutate        func(plat *operatorapi.SonataFlowPlatform)
+		expectedField string
+	}{
+		{
+			name: "unknown build strategy",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Build.Config.BuildStrategy = "kaniko"
+			},
+			expectedField: "spec.build.config.strategy",
+		},
+		{
+			name: "unknown container builder",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Build.Config.BuildStrategyOptions = map[string]string{"ContainerBuilder": "docker"}
+			},
+			expectedField: "spec.build.config.strategyOptions[ContainerBuilder]",
+		},
+		{
+			name: "image signing without key secret",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Build.Config.Signing = &operatorapi.ImageSigningSpec{}
+			},
+			expectedField: "spec.build.config.signing.keySecret",
+		},
+		{
+			name: "registry address with scheme",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Build.Config.Registry.Address = "https://quay.io"
+			},
+			expectedField: "spec.build.config.registry.address",
+		},
+		{
+			name: "platform persistence with jdbcUrl and serviceRef",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+					PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{
+						SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "db-secret"},
+						ServiceRef: &operatorapi.SQLServiceOptions{Name: "db"},
+						JdbcUrl:    "jdbc:postgresql://db:5432/sonataflow",
+					},
+				}
+			},
+			expectedField: "spec.persistence.postgresql.jdbcUrl",
+		},
+		{
+			name: "platform persistence without connection",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+					PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{SecretRef: operatorapi.PostgreSQLSecretOptions{Name: "db-secret"}},
+				}
+			},
+			expectedField: "spec.persistence.postgresql",
+		},
+		{
+			name: "managed platform persistence with jdbcUrl",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+					PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{
+						JdbcUrl: "jdbc:postgresql://db:5432/sonataflow",
+						Managed: &operatorapi.ManagedPostgreSQLSpec{},
+					},
+				}
+			},
+			expectedField: "spec.persistence.postgresql.jdbcUrl",
+		},
+		{
+			name: "platform persistence with postgresql and mysql",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+					PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{
+						SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "db-secret"},
+						ServiceRef: &operatorapi.SQLServiceOptions{Name: "db"},
+					},
+					MySQL: &operatorapi.PersistenceMySQL{
+						SecretRef:  operatorapi.MySQLSecretOptions{Name: "db-secret"},
+						ServiceRef: &operatorapi.SQLServiceOptions{Name: "mysql"},
+					},
+				}
+			},
+			expectedField: "spec.persistence.mysql",
+		},
+		{
+			name: "data index mysql persistence with a postgresql jdbcUrl",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Services = &operatorapi.ServicesPlatformSpec{
+					DataIndex: &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{
+						Persistence: &operatorapi.PersistenceOptionsSpec{MySQL: &operatorapi.PersistenceMySQL{
+							SecretRef: operatorapi.MySQLSecretOptions{Name: "db-secret"},
+							JdbcUrl:   "jdbc:postgresql://db:5432/sonataflow",
+						}},
+					}},
+				}
+			},
+			expectedField: "spec.services.dataIndex.persistence.mysql.jdbcUrl",
+		},
+		{
+			name: "jobs service job based migration of the platform mysql",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+					MySQL: &operatorapi.PersistenceMySQL{
+						SecretRef:  operatorapi.MySQLSecretOptions{Name: "db-secret"},
+						ServiceRef: &operatorapi.SQLServiceOptions{Name: "mysql"},
+					},
+				}
+				plat.Spec.Services = &operatorapi.ServicesPlatformSpec{
+					JobService: &operatorapi.JobServiceServiceSpec{ServiceSpec: operatorapi.ServiceSpec{
+						Persistence: &operatorapi.PersistenceOptionsSpec{DBMigrationStrategy: string(operatorapi.DBMigrationStrategyJob)},
+					}},
+				}
+			},
+			expectedField: "spec.services.jobService.persistence.dbMigrationStrategy",
+		},
+		{
+			name: "data index persistence with an invalid port",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Services = &operatorapi.ServicesPlatformSpec{
+					DataIndex: &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{
+						Persistence: &operatorapi.PersistenceOptionsSpec{PostgreSQL: &operatorapi.PersistencePostgreSQL{
+							SecretRef:  operatorapi.PostgreSQLSecretOptions{Name: "db-secret"},
+							ServiceRef: &operatorapi.PostgreSQLServiceOptions{SQLServiceOptions: &operatorapi.SQLServiceOptions{Name: "db", Port: pointer.Int(0)}},
+						}},
+					}},
+				}
+			},
+			expectedField: "spec.services.dataIndex.persistence.postgresql.serviceRef.port",
+		},
+		{
+			name: "job service persistence with an unknown migration strategy",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Services = &operatorapi.ServicesPlatformSpec{
+					JobService: &operatorapi.JobServiceServiceSpec{ServiceSpec: operatorapi.ServiceSpec{
+						Persistence: &operatorapi.PersistenceOptionsSpec{DBMigrationStrategy: "flyway"},
+					}},
+				}
+			},
+			expectedField: "spec.services.jobService.persistence.dbMigrationStrategy",
+		},
+		{
+			name: "data index disruption budget with an invalid percentage",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				maxUnavailable := intstr.FromString("150%")
+				plat.Spec.Services = &operatorapi.ServicesPlatformSpec{
+					DataIndex: &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{
+						PodTemplate: operatorapi.PodTemplateSpec{DisruptionBudget: &operatorapi.DisruptionBudgetSpec{MaxUnavailable: &maxUnavailable}},
+					}},
+				}
+			},
+			expectedField: "spec.services.dataIndex.podTemplate.disruptionBudget.maxUnavailable",
+		},
+		{
+			name: "duplicated property",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Properties.Flow = append(plat.Spec.Properties.Flow, operatorapi.PropertyVar{Name: "quarkus.log.level", Value: "DEBUG"})
+			},
+			expectedField: "spec.properties.flow[1].name",
+		},
+		{
+			name: "property with value and valueFrom",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Properties.Flow[0].ValueFrom = &operatorapi.PropertyVarSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cm"}, Key: "level"},
+				}
+			},
+			expectedField: "spec.properties.flow[0].valueFrom",
+		},
+		{
+			name: "property source without key",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Properties.Flow[0].Value = ""
+				plat.Spec.Properties.Flow[0].ValueFrom = &operatorapi.PropertyVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}},
+				}
+			},
+			expectedField: "spec.properties.flow[0].valueFrom.secretKeyRef.key",
+		},
+		{
+			name: "exposure with a shared host",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Exposure = &operatorapi.PlatformExposureSpec{ExposureSpec: operatorapi.ExposureSpec{Host: "workflows.example.com"}}
+			},
+			expectedField: "spec.exposure.host",
+		},
+		{
+			name: "exposure gateway without name",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Exposure = &operatorapi.PlatformExposureSpec{
+					ExposureSpec: operatorapi.ExposureSpec{Type: operatorapi.HTTPRouteExposureType, GatewayRef: &operatorapi.GatewayReference{}},
+					Domain:       "example.com",
+				}
+			},
+			expectedField: "spec.exposure.gatewayRef.name",
+		},
+		{
+			name: "http route exposure without gateway",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Exposure = &operatorapi.PlatformExposureSpec{
+					ExposureSpec: operatorapi.ExposureSpec{Type: operatorapi.HTTPRouteExposureType},
+					Domain:       "example.com",
+				}
+			},
+			expectedField: "spec.exposure.gatewayRef",
+		},
+		{
+			name: "kafka combined with broker",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Eventing = &operatorapi.PlatformEventingSpec{
+					Broker: &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Broker", APIVersion: "eventing.knative.dev/v1", Name: "default"}},
+					Kafka:  &operatorapi.KafkaClusterSpec{BootstrapServers: "kafka:9092"},
+				}
+			},
+			expectedField: "spec.eventing.kafka",
+		},
+		{
+			name: "disabled platform exposure",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.Exposure = &operatorapi.PlatformExposureSpec{ExposureSpec: operatorapi.ExposureSpec{Disabled: true}}
+			},
+			expectedField: "spec.exposure.disabled",
+		},
+		{
+			name: "tls without certificate source",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.TLS = &operatorapi.PlatformTLSSpec{Enabled: true}
+			},
+			expectedField: "spec.tls",
+		},
+		{
+			name: "tls with secretRef and certManager",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.TLS = &operatorapi.PlatformTLSSpec{
+					Enabled:     true,
+					SecretRef:   "platform-tls",
+					CertManager: &operatorapi.CertManagerTLSSpec{IssuerRef: operatorapi.CertManagerIssuerReference{Name: "ca-issuer"}},
+				}
+			},
+			expectedField: "spec.tls.certManager",
+		},
+		{
+			name: "tls certManager without issuer name",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.TLS = &operatorapi.PlatformTLSSpec{Enabled: true, CertManager: &operatorapi.CertManagerTLSSpec{}}
+			},
+			expectedField: "spec.tls.certManager.issuerRef.name",
+		},
+		{
+			name: "tls requiring client certificates",
+			mutate: func(plat *operatorapi.SonataFlowPlatform) {
+				plat.Spec.TLS = &operatorapi.PlatformTLSSpec{Enabled: true, SecretRef: "platform-tls", ClientAuth: operatorapi.TLSClientAuthRequired}
+			},
+			expectedField: "spec.tls.clientAuth: Forbidden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plat := test.GetBasePlatform()
+			plat.Namespace = t.Name()
+			tt.mutate(plat)
+			validator := &SonataFlowPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+			_, err := validator.ValidateCreate(context.TODO(), plat)
+			assert.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tt.expectedField)
+		})
+	}
+}
+
+func TestSonataFlowPlatformCustomValidator_Duplicated(t *testing.T) {
+	namespace := t.Name()
+	active := test.GetBasePlatformInReadyPhase(namespace)
+	newPlatform := func(name string) *operatorapi.SonataFlowPlatform {
+		plat := test.GetBasePlatform()
+		plat.Name = name
+		plat.Namespace = namespace
+		return plat
+	}
+	validator := &SonataFlowPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().WithRuntimeObjects(active).Build()}
+
+	t.Run("second platform is refused", func(t *testing.T) {
+		_, err := validator.ValidateCreate(context.TODO(), newPlatform("another-platform"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "metadata.name: Forbidden")
+	})
+	t.Run("secondary platform is allowed", func(t *testing.T) {
+		plat := newPlatform("secondary-platform")
+		plat.Annotations = map[string]string{metadata.SecondaryPlatformAnnotation: "true"}
+		_, err := validator.ValidateCreate(context.TODO(), plat)
+		assert.NoError(t, err)
+	})
+	t.Run("platform in another namespace is allowed", func(t *testing.T) {
+		plat := newPlatform("another-platform")
+		plat.Namespace = "another-namespace"
+		_, err := validator.ValidateCreate(context.TODO(), plat)
+		assert.NoError(t, err)
+	})
+	t.Run("duplicated platforms remain editable", func(t *testing.T) {
+		plat := newPlatform("duplicated-platform")
+		plat.Status.Manager().MarkFalse(api.SucceedConditionType, operatorapi.PlatformDuplicatedReason, "")
+		_, err := validator.ValidateUpdate(context.TODO(), plat, plat)
+		assert.NoError(t, err)
+	})
+	t.Run("updating the active platform is allowed", func(t *testing.T) {
+		_, err := validator.ValidateUpdate(context.TODO(), active, active)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSonataFlowPlatformCustomValidator_ManagedPostgreSQL(t *testing.T) {
+	plat := test.GetBasePlatform()
+	plat.Namespace = t.Name()
+	plat.Spec.Persistence = &operatorapi.PlatformPersistenceOptionsSpec{
+		PostgreSQL: &operatorapi.PlatformPersistencePostgreSQL{Managed: &operatorapi.ManagedPostgreSQLSpec{}},
+	}
+	validator := &SonataFlowPlatformCustomValidator{Client: test.NewSonataFlowClientBuilder().Build()}
+	_, err := validator.ValidateCreate(context.TODO(), plat)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,56 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+var supportedTLSClientAuths = []operatorapi.TLSClientAuth{operatorapi.TLSClientAuthNone, operatorapi.TLSClientAuthRequest}
+
+// validatePlatformTLS verifies that an enabled platform TLS has exactly one certificate source.
+// Client certificates can't be required yet: the exposure backends, the Knative sinks and the consumers of other
+// namespaces still reach the services over the plain HTTP port without presenting one.
+func validatePlatformTLS(tls *operatorapi.PlatformTLSSpec, fldPath *field.Path) field.ErrorList {
+	if tls == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	switch {
+	case len(tls.SecretRef) > 0 && tls.CertManager != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certManager"), "", "may not be specified when `secretRef` is not empty"))
+	case tls.Enabled && len(tls.SecretRef) == 0 && tls.CertManager == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of secretRef or certManager must be defined"))
+	}
+	if tls.CertManager != nil && len(tls.CertManager.IssuerRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("certManager", "issuerRef", "name"), "the issuer name must be defined"))
+	}
+	switch tls.ClientAuth {
+	case "", operatorapi.TLSClientAuthNone, operatorapi.TLSClientAuthRequest:
+	case operatorapi.TLSClientAuthRequired:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("clientAuth"),
+			"client certificates can't be required, the Ingress and HTTPRoute backends, the Knative sinks and the consumers of other namespaces don't present one"))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("clientAuth"), tls.ClientAuth, supportedTLSClientAuths))
+	}
+	return allErrs
+}
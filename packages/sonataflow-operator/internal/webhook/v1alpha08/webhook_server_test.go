@@ -0,0 +1,236 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha08
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+	operatorapiv1beta1 "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1beta1"
+	"github.com/apache/incubator-kie-tools/packages/sonataflow-operator/test"
+)
+
+// webhookTestServer serves the admission webhooks over TLS with the fake certificates generated by envtest.
+type webhookTestServer struct {
+	client  *http.Client
+	baseURL string
+}
+
+func startWebhookTestServer(t *testing.T, handlers map[string]http.Handler) *webhookTestServer {
+	options := &envtest.WebhookInstallOptions{}
+	if err := options.PrepWithoutInstalling(); err != nil {
+		t.Fatalf("failed to generate the webhook serving certificates: %v", err)
+	}
+	t.Cleanup(func() { _ = options.Cleanup() })
+
+	server := webhook.NewServer(webhook.Options{
+		Host:    options.LocalServingHost,
+		Port:    options.LocalServingPort,
+		CertDir: options.LocalServingCertDir,
+	})
+	for path, handler := range handlers {
+		server.Register(path, handler)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		if err := server.Start(ctx); err != nil {
+			t.Errorf("webhook server failed: %v", err)
+		}
+	}()
+
+	caPool := x509.NewCertPool()
+	caPool.AppendCertsFromPEM(options.LocalServingCAData)
+	s := &webhookTestServer{
+		client:  &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caPool}}},
+		baseURL: fmt.Sprintf("https://%s:%d", options.LocalServingHost, options.LocalServingPort),
+	}
+	assert.Eventually(t, func() bool {
+		return server.StartedChecker()(nil) == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	return s
+}
+
+func (s *webhookTestServer) review(t *testing.T, path string, operation admissionv1.Operation, obj runtime.Object) *admissionv1.AdmissionResponse {
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	review := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID(t.Name()),
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	if operation == admissionv1.Update {
+		review.Request.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	review.SetGroupVersionKind(admissionv1.SchemeGroupVersion.WithKind("AdmissionReview"))
+	body, err := json.Marshal(review)
+	assert.NoError(t, err)
+	resp, err := s.client.Post(s.baseURL+path, "application/json", bytes.NewReader(body))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer resp.Body.Close()
+	result := &admissionv1.AdmissionReview{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+	if !assert.NotNil(t, result.Response) {
+		t.FailNow()
+	}
+	return result.Response
+}
+
+func (s *webhookTestServer) convert(t *testing.T, desiredAPIVersion string, obj runtime.Object) *apiextensionsv1.ConversionResponse {
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	review := &apiextensionsv1.ConversionReview{
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               types.UID(t.Name()),
+			DesiredAPIVersion: desiredAPIVersion,
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	}
+	review.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("ConversionReview"))
+	body, err := json.Marshal(review)
+	assert.NoError(t, err)
+	resp, err := s.client.Post(s.baseURL+"/convert", "application/json", bytes.NewReader(body))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer resp.Body.Close()
+	result := &apiextensionsv1.ConversionReview{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+	if !assert.NotNil(t, result.Response) {
+		t.FailNow()
+	}
+	return result.Response
+}
+
+func TestSonataFlowWebhookServer(t *testing.T) {
+	cli := test.NewSonataFlowClientBuilder().Build()
+	server := startWebhookTestServer(t, map[string]http.Handler{
+		"/mutate-sonataflow-org-v1alpha08-sonataflow":   admission.WithCustomDefaulter(scheme.Scheme, &operatorapi.SonataFlow{}, &SonataFlowCustomDefaulter{}),
+		"/validate-sonataflow-org-v1alpha08-sonataflow": admission.WithCustomValidator(scheme.Scheme, &operatorapi.SonataFlow{}, &SonataFlowCustomValidator{Client: cli}),
+	})
+
+	t.Run("defaults are patched", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		response := server.review(t, "/mutate-sonataflow-org-v1alpha08-sonataflow", admissionv1.Create, workflow)
+		assert.True(t, response.Allowed)
+		assert.Contains(t, string(response.Patch), "sonataflow.org~1profile")
+	})
+	t.Run("valid workflow is allowed", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		response := server.review(t, "/validate-sonataflow-org-v1alpha08-sonataflow", admissionv1.Create, workflow)
+		assert.True(t, response.Allowed)
+	})
+	t.Run("invalid workflow is denied with the field path", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		workflow.Spec.Flow.Start.StateName = "NotAState"
+		response := server.review(t, "/validate-sonataflow-org-v1alpha08-sonataflow", admissionv1.Update, workflow)
+		assert.False(t, response.Allowed)
+		assert.Contains(t, response.Result.Message, "spec.flow.start")
+	})
+}
+
+func TestSonataFlowPlatformWebhookServer(t *testing.T) {
+	namespace := t.Name()
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(test.GetBasePlatformInReadyPhase(namespace)).Build()
+	server := startWebhookTestServer(t, map[string]http.Handler{
+		"/validate-sonataflow-org-v1alpha08-sonataflowplatform":        admission.WithCustomValidator(scheme.Scheme, &operatorapi.SonataFlowPlatform{}, &SonataFlowPlatformCustomValidator{Client: cli}),
+		"/validate-sonataflow-org-v1alpha08-sonataflowclusterplatform": admission.WithCustomValidator(scheme.Scheme, &operatorapi.SonataFlowClusterPlatform{}, &SonataFlowClusterPlatformCustomValidator{Client: cli}),
+	})
+
+	t.Run("duplicated platform is denied", func(t *testing.T) {
+		plat := test.GetBasePlatform()
+		plat.Name = "another-platform"
+		plat.Namespace = namespace
+		response := server.review(t, "/validate-sonataflow-org-v1alpha08-sonataflowplatform", admissionv1.Create, plat)
+		assert.False(t, response.Allowed)
+		assert.Contains(t, response.Result.Message, namespace)
+	})
+	t.Run("unknown build strategy is denied", func(t *testing.T) {
+		plat := test.GetBasePlatformInReadyPhase(namespace)
+		plat.Spec.Build.Config.BuildStrategy = "kaniko"
+		response := server.review(t, "/validate-sonataflow-org-v1alpha08-sonataflowplatform", admissionv1.Update, plat)
+		assert.False(t, response.Allowed)
+		assert.Contains(t, response.Result.Message, "spec.build.config.strategy")
+	})
+	t.Run("cluster platform is allowed", func(t *testing.T) {
+		cPlatform := test.GetBaseClusterPlatformInReadyPhase(namespace)
+		response := server.review(t, "/validate-sonataflow-org-v1alpha08-sonataflowclusterplatform", admissionv1.Create, cPlatform)
+		assert.True(t, response.Allowed)
+	})
+}
+
+func TestConversionWebhookServer(t *testing.T) {
+	s := runtime.NewScheme()
+	utilruntime.Must(operatorapi.AddToScheme(s))
+	utilruntime.Must(operatorapiv1beta1.AddToScheme(s))
+	server := startWebhookTestServer(t, map[string]http.Handler{"/convert": conversion.NewWebhookHandler(s)})
+
+	t.Run("workflow is served as v1beta1", func(t *testing.T) {
+		workflow := test.GetBaseSonataFlow(t.Name())
+		workflow.Spec.Persistence = &operatorapi.PersistenceOptionsSpec{DBMigrationStrategy: string(operatorapi.DBMigrationStrategyJob)}
+		response := server.convert(t, operatorapiv1beta1.GroupVersion.String(), workflow)
+		assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+		assert.Len(t, response.ConvertedObjects, 1)
+		converted := &operatorapiv1beta1.SonataFlow{}
+		assert.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, converted))
+		assert.Equal(t, operatorapiv1beta1.GroupVersion.String(), converted.APIVersion)
+		assert.Equal(t, workflow.Spec.Flow.States[0].Name, converted.Spec.Flow.States[0].Name)
+		assert.Equal(t, operatorapiv1beta1.DBMigrationStrategyJob, converted.Spec.Persistence.DBMigrationStrategy)
+	})
+	t.Run("build is stored as v1alpha08", func(t *testing.T) {
+		build := &operatorapiv1beta1.SonataFlowBuild{
+			TypeMeta:   metav1.TypeMeta{APIVersion: operatorapiv1beta1.GroupVersion.String(), Kind: "SonataFlowBuild"},
+			ObjectMeta: metav1.ObjectMeta{Name: "greeting", Namespace: t.Name()},
+			Status: operatorapiv1beta1.SonataFlowBuildStatus{
+				InnerBuild: &operatorapiv1beta1.InnerBuildStatus{Kind: "ContainerBuild", State: &runtime.RawExtension{Raw: []byte(`{"kind":"ContainerBuild"}`)}},
+			},
+		}
+		response := server.convert(t, operatorapi.GroupVersion.String(), build)
+		assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+		converted := &operatorapi.SonataFlowBuild{}
+		assert.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, converted))
+		assert.JSONEq(t, `{"kind":"ContainerBuild"}`, string(converted.Status.InnerBuild.Raw))
+	})
+}
@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+// DefaultTopologySpreadKeys are the node labels used to spread the pods when the TopologySpreadSpec doesn't declare any.
+var DefaultTopologySpreadKeys = []string{corev1.LabelTopologyZone, corev1.LabelHostname}
+
+// NewPodDisruptionBudgetSpec returns the PodDisruptionBudgetSpec selecting the pods with the given labels.
+// When neither minAvailable nor maxUnavailable are declared, at most one pod can be disrupted at a time.
+func NewPodDisruptionBudgetSpec(budget *operatorapi.DisruptionBudgetSpec, selector map[string]string) policyv1.PodDisruptionBudgetSpec {
+	pdbSpec := policyv1.PodDisruptionBudgetSpec{
+		Selector: &metav1.LabelSelector{MatchLabels: selector},
+	}
+	if budget.MinAvailable != nil {
+		minAvailable := *budget.MinAvailable
+		pdbSpec.MinAvailable = &minAvailable
+	} else if budget.MaxUnavailable != nil {
+		maxUnavailable := *budget.MaxUnavailable
+		pdbSpec.MaxUnavailable = &maxUnavailable
+	} else {
+		maxUnavailable := intstr.FromInt32(1)
+		pdbSpec.MaxUnavailable = &maxUnavailable
+	}
+	return pdbSpec
+}
+
+// SetDefaultTopologySpreadConstraints adds one constraint for each topology key declared in the TopologySpreadSpec
+// to the given PodSpec. The PodSpec is left untouched if it already declares its own constraints.
+func SetDefaultTopologySpreadConstraints(spread *operatorapi.TopologySpreadSpec, selector map[string]string, podSpec *corev1.PodSpec) {
+	if spread == nil || len(podSpec.TopologySpreadConstraints) > 0 {
+		return
+	}
+	maxSkew := int32(1)
+	if spread.MaxSkew != nil {
+		maxSkew = *spread.MaxSkew
+	}
+	whenUnsatisfiable := spread.WhenUnsatisfiable
+	if len(whenUnsatisfiable) == 0 {
+		whenUnsatisfiable = corev1.ScheduleAnyway
+	}
+	topologyKeys := spread.TopologyKeys
+	if len(topologyKeys) == 0 {
+		topologyKeys = DefaultTopologySpreadKeys
+	}
+	for _, topologyKey := range topologyKeys {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: selector},
+		})
+	}
+}
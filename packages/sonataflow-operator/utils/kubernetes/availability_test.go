@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	operatorapi "github.com/apache/incubator-kie-tools/packages/sonataflow-operator/api/v1alpha08"
+)
+
+func Test_NewPodDisruptionBudgetSpec(t *testing.T) {
+	selector := map[string]string{"app": "workflow"}
+	t.Run("defaults to one unavailable pod", func(t *testing.T) {
+		spec := NewPodDisruptionBudgetSpec(&operatorapi.DisruptionBudgetSpec{}, selector)
+		assert.Nil(t, spec.MinAvailable)
+		assert.Equal(t, intstr.FromInt32(1), *spec.MaxUnavailable)
+		assert.Equal(t, selector, spec.Selector.MatchLabels)
+	})
+	t.Run("uses the declared min available pods", func(t *testing.T) {
+		minAvailable := intstr.FromString("50%")
+		spec := NewPodDisruptionBudgetSpec(&operatorapi.DisruptionBudgetSpec{MinAvailable: &minAvailable}, selector)
+		assert.Equal(t, minAvailable, *spec.MinAvailable)
+		assert.Nil(t, spec.MaxUnavailable)
+	})
+}
+
+func Test_SetDefaultTopologySpreadConstraints(t *testing.T) {
+	selector := map[string]string{"app": "workflow"}
+	t.Run("adds the default constraints", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		SetDefaultTopologySpreadConstraints(&operatorapi.TopologySpreadSpec{}, selector, podSpec)
+		assert.Len(t, podSpec.TopologySpreadConstraints, 2)
+		assert.Equal(t, corev1.LabelTopologyZone, podSpec.TopologySpreadConstraints[0].TopologyKey)
+		assert.Equal(t, corev1.LabelHostname, podSpec.TopologySpreadConstraints[1].TopologyKey)
+		assert.Equal(t, int32(1), podSpec.TopologySpreadConstraints[0].MaxSkew)
+		assert.Equal(t, corev1.ScheduleAnyway, podSpec.TopologySpreadConstraints[0].WhenUnsatisfiable)
+		assert.Equal(t, selector, podSpec.TopologySpreadConstraints[0].LabelSelector.MatchLabels)
+	})
+	t.Run("uses the declared keys", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		SetDefaultTopologySpreadConstraints(&operatorapi.TopologySpreadSpec{
+			MaxSkew:           ptr.To(int32(2)),
+			TopologyKeys:      []string{corev1.LabelHostname},
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+		}, selector, podSpec)
+		assert.Len(t, podSpec.TopologySpreadConstraints, 1)
+		assert.Equal(t, int32(2), podSpec.TopologySpreadConstraints[0].MaxSkew)
+		assert.Equal(t, corev1.DoNotSchedule, podSpec.TopologySpreadConstraints[0].WhenUnsatisfiable)
+	})
+	t.Run("keeps the user constraints", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{TopologyKey: "rack"}}}
+		SetDefaultTopologySpreadConstraints(&operatorapi.TopologySpreadSpec{}, selector, podSpec)
+		assert.Len(t, podSpec.TopologySpreadConstraints, 1)
+		assert.Equal(t, "rack", podSpec.TopologySpreadConstraints[0].TopologyKey)
+	})
+}
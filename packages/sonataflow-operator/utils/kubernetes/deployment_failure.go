@@ -22,6 +22,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,8 @@ import (
 
 const (
 	containerReasonContainerCreating = "ContainerCreating"
+	containerReasonErrImagePull      = "ErrImagePull"
+	containerReasonImagePullBackOff  = "ImagePullBackOff"
 )
 
 var _ DeploymentUnavailabilityReader = &deploymentUnavailabilityReader{}
@@ -100,3 +103,8 @@ func (d deploymentUnavailabilityReader) ReasonMessage() (string, error) {
 
 	return "", nil
 }
+
+// IsImagePullFailure whether the given ReasonMessage reports a container that can't pull its image.
+func IsImagePullFailure(message string) bool {
+	return strings.Contains(message, "("+containerReasonErrImagePull+")") || strings.Contains(message, "("+containerReasonImagePullBackOff+")")
+}
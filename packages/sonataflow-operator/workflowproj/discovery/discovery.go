@@ -0,0 +1,220 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package discovery holds the service discovery uris model, e.g. kubernetes:services.v1/my-namespace/my-service, and
+// the parser shared by the operator, which resolves the uris into addresses, and the tooling explaining them.
+package discovery
+
+import (
+	"fmt"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KnativeScheme    = "knative"
+	KubernetesScheme = "kubernetes"
+	OpenshiftScheme  = "openshift"
+	GatewayScheme    = "gateway"
+	IstioScheme      = "istio"
+	ConfigScheme     = "config"
+
+	// PortQueryParam well known query param to select a particular target port, for example when a service is being
+	// discovered and there are many ports to select.
+	PortQueryParam = "port"
+
+	// KeyQueryParam well known query param to select the entry holding the address when a configmap or a secret is
+	// being discovered.
+	KeyQueryParam = "key"
+
+	// kubernetes groups
+	kubernetesServices     = "kubernetes:services.v1"
+	kubernetesPods         = "kubernetes:pods.v1"
+	kubernetesDeployments  = "kubernetes:deployments.v1.apps"
+	kubernetesStatefulSets = "kubernetes:statefulsets.v1.apps"
+	kubernetesIngresses    = "kubernetes:ingresses.v1.networking.k8s.io"
+
+	// knative groups
+	knativeServices = "knative:services.v1.serving.knative.dev"
+	knativeBrokers  = "knative:brokers.v1.eventing.knative.dev"
+
+	// openshift groups
+	openshiftRoutes            = "openshift:routes.v1.route.openshift.io"
+	openshiftDeploymentConfigs = "openshift:deploymentconfigs.v1.apps.openshift.io"
+
+	// gateway api groups
+	gatewayHTTPRoutes = "gateway:httproutes.v1.gateway.networking.k8s.io"
+	gatewayGateways   = "gateway:gateways.v1.gateway.networking.k8s.io"
+
+	// istio groups
+	istioVirtualServices        = "istio:virtualservices.v1.networking.istio.io"
+	istioVirtualServicesV1beta1 = "istio:virtualservices.v1beta1.networking.istio.io"
+	istioServiceEntries         = "istio:serviceentries.v1.networking.istio.io"
+	istioServiceEntriesV1beta1  = "istio:serviceentries.v1beta1.networking.istio.io"
+
+	// config groups
+	configConfigMaps = "config:configmaps.v1"
+	configSecrets    = "config:secrets.v1"
+)
+
+type ResourceUri struct {
+	Scheme      string
+	GVK         v1.GroupVersionKind
+	Namespace   string
+	Name        string
+	QueryParams map[string]string
+}
+
+type ResourceUriBuilder struct {
+	uri *ResourceUri
+}
+
+func NewResourceUriBuilder(scheme string) ResourceUriBuilder {
+	return ResourceUriBuilder{
+		uri: &ResourceUri{
+			Scheme:      scheme,
+			GVK:         v1.GroupVersionKind{},
+			QueryParams: map[string]string{},
+		},
+	}
+}
+
+func (b ResourceUriBuilder) Kind(kind string) ResourceUriBuilder {
+	b.uri.GVK.Kind = kind
+	return b
+}
+
+func (b ResourceUriBuilder) Version(version string) ResourceUriBuilder {
+	b.uri.GVK.Version = version
+	return b
+}
+
+func (b ResourceUriBuilder) Group(group string) ResourceUriBuilder {
+	b.uri.GVK.Group = group
+	return b
+}
+
+func (b ResourceUriBuilder) Namespace(namespace string) ResourceUriBuilder {
+	b.uri.Namespace = namespace
+	return b
+}
+
+func (b ResourceUriBuilder) Name(name string) ResourceUriBuilder {
+	b.uri.Name = name
+	return b
+}
+
+func (b ResourceUriBuilder) WithPort(customPort string) ResourceUriBuilder {
+	b.uri.SetPort(customPort)
+	return b
+}
+
+func (b ResourceUriBuilder) WithQueryParam(param string, value string) ResourceUriBuilder {
+	b.uri.AddQueryParam(param, value)
+	return b
+}
+
+func (b ResourceUriBuilder) Build() *ResourceUri {
+	return b.uri
+}
+
+func (r *ResourceUri) AddQueryParam(name string, value string) {
+	if len(value) > 0 {
+		r.QueryParams[name] = value
+	}
+}
+
+func (r *ResourceUri) GetQueryParam(name string) string {
+	if len(name) > 0 {
+		return r.QueryParams[name]
+	}
+	return ""
+}
+
+func (r *ResourceUri) SetPort(value string) {
+	r.AddQueryParam(PortQueryParam, value)
+}
+
+func (r *ResourceUri) GetPort() string {
+	return r.GetQueryParam(PortQueryParam)
+}
+
+func (r *ResourceUri) GetKey() string {
+	return r.GetQueryParam(KeyQueryParam)
+}
+
+// IsSecret returns true if the uri points to a secret entry, and thus, the resolved address must not be logged.
+func (r *ResourceUri) IsSecret() bool {
+	return r.Scheme == ConfigScheme && r.GVK.Kind == "secrets"
+}
+
+// GetCustomLabels returns all the query parameters that not considered well known query parameters, and thus, has no
+// particular semantic during the discovery. These arbitrary parameters are normally considered as labels, and when
+// present, and the service discovery must give a preference over a set of resources, they can be used to do a filtering.
+// by labels.
+func (r *ResourceUri) GetCustomLabels() map[string]string {
+	customQueryParams := make(map[string]string)
+	for k, v := range r.QueryParams {
+		if !isWellKnownQueryParam(k) && len(v) > 0 {
+			customQueryParams[k] = v
+		}
+	}
+	return customQueryParams
+}
+
+func isWellKnownQueryParam(k string) bool {
+	return k == PortQueryParam || k == KeyQueryParam
+}
+
+func (r *ResourceUri) String() string {
+	if r == nil {
+		return ""
+	}
+	gvk := appendWithDelimiter("", r.GVK.Kind, ".")
+	gvk = appendWithDelimiter(gvk, r.GVK.Version, ".")
+	gvk = appendWithDelimiter(gvk, r.GVK.Group, ".")
+	uri := r.Scheme + ":" + gvk
+	uri = appendWithDelimiter(uri, r.Namespace, "/")
+	uri = appendWithDelimiter(uri, r.Name, "/")
+
+	return appendWithDelimiter(uri, buildLabelsString(r.QueryParams, "&"), "?")
+}
+
+func appendWithDelimiter(value string, toAppend string, delimiter string) string {
+	if len(toAppend) > 0 {
+		if len(value) > 0 {
+			return fmt.Sprintf("%s%s%s", value, delimiter, toAppend)
+		} else {
+			return fmt.Sprintf("%s%s", value, toAppend)
+		}
+	}
+	return value
+}
+
+func buildParam(name string, value string) string {
+	return fmt.Sprintf("%s=%s", name, value)
+}
+
+func buildLabelsString(labels map[string]string, delimiter string) string {
+	var labelsStr string
+	for name, value := range labels {
+		labelsStr = appendWithDelimiter(labelsStr, buildParam(name, value), delimiter)
+	}
+	return labelsStr
+}
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+)
+
+const (
+	discoveryLikePropertyPattern  = "^\\${(kubernetes|knative|openshift|gateway|istio|config):(.*)}$"
+	knativeServiceOperationPrefix = "knative:services.v1.serving.knative.dev"
+)
+
+var discoveryLikePropertyExpr = regexp.MustCompile(discoveryLikePropertyPattern)
+
+// Reference is a service discovery uri referenced by a workflow project.
+type Reference struct {
+	// Source name of the property, or the function, referencing the uri.
+	Source string
+	// Uri the plain service discovery uri, for example: kubernetes:services.v1/my-namespace/my-service.
+	Uri string
+	// Function is true when the uri is the operation of a function invoking a knative service.
+	Function bool
+}
+
+// PropertyReference returns the reference held by the given property if its value looks like a service discovery
+// configuration, e.g. ${kubernetes:services.v1/my-service}.
+func PropertyReference(name string, value string) (Reference, bool) {
+	if !discoveryLikePropertyExpr.MatchString(value) {
+		return Reference{}, false
+	}
+	return Reference{Source: name, Uri: value[2 : len(value)-1]}, true
+}
+
+// FunctionReference returns the reference held by the given function if its operation is a knative service invocation.
+func FunctionReference(function cncfmodel.Function) (Reference, bool) {
+	if !strings.HasPrefix(function.Operation, knativeServiceOperationPrefix) {
+		return Reference{}, false
+	}
+	return Reference{Source: function.Name, Uri: function.Operation, Function: true}, true
+}
+
+// FindReferences returns the service discovery references held by the given properties and functions.
+func FindReferences(props *properties.Properties, functions []cncfmodel.Function) []Reference {
+	var references []Reference
+	if props != nil {
+		for _, k := range props.Keys() {
+			value, _ := props.Get(k)
+			if reference, ok := PropertyReference(k, value); ok {
+				references = append(references, reference)
+			}
+		}
+	}
+	for _, function := range functions {
+		if reference, ok := FunctionReference(function); ok {
+			references = append(references, reference)
+		}
+	}
+	return references
+}
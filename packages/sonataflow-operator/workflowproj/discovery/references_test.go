@@ -0,0 +1,47 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindReferences(t *testing.T) {
+	props := properties.MustLoadString("property1=value1\n" +
+		"service1=${kubernetes:services.v1/namespace1/my-service1}\n" +
+		"billing=${config:configmaps.v1/shared/endpoints?key=billing}\n" +
+		"property2=${value2}\n")
+	props.DisableExpansion = true
+	functions := []cncfmodel.Function{
+		{Name: "knServiceInvocation", Operation: "knative:services.v1.serving.knative.dev/my-kn-service?path=/function"},
+		{Name: "restInvocation", Operation: "specs/api.yaml#operation"},
+	}
+
+	references := FindReferences(props, functions)
+	assert.Equal(t, []Reference{
+		{Source: "service1", Uri: "kubernetes:services.v1/namespace1/my-service1"},
+		{Source: "billing", Uri: "config:configmaps.v1/shared/endpoints?key=billing"},
+		{Source: "knServiceInvocation", Uri: "knative:services.v1.serving.knative.dev/my-kn-service?path=/function", Function: true},
+	}, references)
+}
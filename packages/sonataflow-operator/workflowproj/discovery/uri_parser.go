@@ -31,7 +31,7 @@ const (
 	// valid namespace, name, or label name.
 	dns1123LabelFmt string = "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
 	queryParamName         = "[a-zA-Z0-9][-a-zAz0-9]*"
-	queryParamValue        = "[/a-zA-Z0-9][/-a-zAz0-9]*"
+	queryParamValue        = "[/a-zA-Z0-9][/-a-zAz0-9._]*"
 
 	namespaceAndNamePattern = "^/((" + dns1123LabelFmt + ")+)(/(" + dns1123LabelFmt + ")+)?"
 	queryStringPattern      = "^(\\?((" + queryParamName + ")+\\=(" + queryParamValue + ")+)" +
@@ -49,12 +49,26 @@ const (
 
 	openshiftGroupsPattern = "^(" + openshiftDeploymentConfigs +
 		"|" + openshiftRoutes + ")"
+
+	gatewayGroupsPattern = "^(" + gatewayHTTPRoutes +
+		"|" + gatewayGateways + ")"
+
+	istioGroupsPattern = "^(" + istioVirtualServices +
+		"|" + istioVirtualServicesV1beta1 +
+		"|" + istioServiceEntries +
+		"|" + istioServiceEntriesV1beta1 + ")"
+
+	configGroupsPattern = "^(" + configConfigMaps +
+		"|" + configSecrets + ")"
 )
 
 var kubernetesGroupsExpr = regexp.MustCompile(kubernetesGroupsPattern)
 var knativeGroupsExpr = regexp.MustCompile(knativeGroupsPattern)
 var knativeSimplifiedServiceExpr = regexp.MustCompile(knativeSimplifiedServicePatten)
 var openshiftGroupsExpr = regexp.MustCompile(openshiftGroupsPattern)
+var gatewayGroupsExpr = regexp.MustCompile(gatewayGroupsPattern)
+var istioGroupsExpr = regexp.MustCompile(istioGroupsPattern)
+var configGroupsExpr = regexp.MustCompile(configGroupsPattern)
 var namespaceAndNameExpr = regexp.MustCompile(namespaceAndNamePattern)
 var queryStringExpr = regexp.MustCompile(queryStringPattern)
 
@@ -67,8 +81,14 @@ func ParseUri(uri string) (*ResourceUri, error) {
 		return parseKnativeSimplifiedServiceUri(uri)
 	} else if split := openshiftGroupsExpr.Split(uri, -1); len(split) == 2 {
 		return parseOpenshiftUri(uri, openshiftGroupsExpr.FindString(uri), split[1])
+	} else if split := gatewayGroupsExpr.Split(uri, -1); len(split) == 2 {
+		return parseSchemeUri(GatewayScheme, uri, gatewayGroupsExpr.FindString(uri), split[1])
+	} else if split := istioGroupsExpr.Split(uri, -1); len(split) == 2 {
+		return parseSchemeUri(IstioScheme, uri, istioGroupsExpr.FindString(uri), split[1])
+	} else if split := configGroupsExpr.Split(uri, -1); len(split) == 2 {
+		return parseConfigUri(uri, configGroupsExpr.FindString(uri), split[1])
 	}
-	return nil, fmt.Errorf("invalid uri: %s, not correspond to any of the available schemes format: %s, %s, %s", uri, KubernetesScheme, KnativeScheme, OpenshiftScheme)
+	return nil, fmt.Errorf("invalid uri: %s, not correspond to any of the available schemes format: %s, %s, %s, %s, %s, %s", uri, KubernetesScheme, KnativeScheme, OpenshiftScheme, GatewayScheme, IstioScheme, ConfigScheme)
 }
 
 func parseKubernetesUri(uri string, schemaAndGroup string, after string) (*ResourceUri, error) {
@@ -180,6 +200,40 @@ func parseGVK(schemaGvk string) (*v1.GroupVersionKind, error) {
 			Version: "v1",
 			Kind:    "deploymentconfigs",
 		}, nil
+	case gatewayHTTPRoutes:
+		return &v1.GroupVersionKind{
+			Group:   "gateway.networking.k8s.io",
+			Version: "v1",
+			Kind:    "httproutes",
+		}, nil
+	case gatewayGateways:
+		return &v1.GroupVersionKind{
+			Group:   "gateway.networking.k8s.io",
+			Version: "v1",
+			Kind:    "gateways",
+		}, nil
+	case istioVirtualServices, istioVirtualServicesV1beta1:
+		return &v1.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: strings.Split(schemaGvk, ".")[1],
+			Kind:    "virtualservices",
+		}, nil
+	case istioServiceEntries, istioServiceEntriesV1beta1:
+		return &v1.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: strings.Split(schemaGvk, ".")[1],
+			Kind:    "serviceentries",
+		}, nil
+	case configConfigMaps:
+		return &v1.GroupVersionKind{
+			Version: "v1",
+			Kind:    "configmaps",
+		}, nil
+	case configSecrets:
+		return &v1.GroupVersionKind{
+			Version: "v1",
+			Kind:    "secrets",
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown schema and gvk: %s", schemaGvk)
 	}
@@ -240,3 +294,28 @@ func parseOpenshiftUri(uri string, schemaAndGroup string, after string) (*Resour
 		}, nil
 	}
 }
+
+func parseSchemeUri(scheme string, uri string, schemaAndGroup string, after string) (*ResourceUri, error) {
+	if namespace, name, gvk, queryParams, err := parseNamespaceNameGVKAndQueryParams(uri, schemaAndGroup, after); err != nil {
+		return nil, err
+	} else {
+		return &ResourceUri{
+			Scheme:      scheme,
+			GVK:         *gvk,
+			Namespace:   namespace,
+			Name:        name,
+			QueryParams: queryParams,
+		}, nil
+	}
+}
+
+func parseConfigUri(uri string, schemaAndGroup string, after string) (*ResourceUri, error) {
+	if resourceUri, err := parseSchemeUri(ConfigScheme, uri, schemaAndGroup, after); err != nil {
+		return nil, err
+	} else if len(resourceUri.GetKey()) == 0 {
+		return nil, fmt.Errorf("invalid %s uri: %s, the %s query parameter is required to select the entry holding the address, "+
+			"for example: /my-namespace/my-configmap?%s=my-service", schemaAndGroup, uri, KeyQueryParam, KeyQueryParam)
+	} else {
+		return resourceUri, nil
+	}
+}
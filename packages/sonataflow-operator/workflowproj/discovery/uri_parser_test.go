@@ -270,6 +270,101 @@ var OpenshiftDeploymentConfigsTestValues = map[string]*ResourceUri{
 		Build(),
 }
 
+var GatewayHTTPRoutesTestValues = map[string]*ResourceUri{
+	"gateway:httproutes.v1.gateway.networking.k8s.io": nil,
+
+	"gateway:httproutes.v1.gateway.networking.k8s.io/my-route": NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Name("my-route").
+		Build(),
+
+	"gateway:httproutes.v1.gateway.networking.k8s.io/my-namespace/my-route": NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace("my-namespace").
+		Name("my-route").
+		Build(),
+}
+
+var GatewayGatewaysTestValues = map[string]*ResourceUri{
+	"gateway:gateways.v1.gateway.networking.k8s.io/my-namespace/my-gateway?port=https": NewResourceUriBuilder(GatewayScheme).
+		Kind("gateways").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace("my-namespace").
+		Name("my-gateway").
+		WithPort("https").
+		Build(),
+
+	"gateway:gateways.v1beta1.gateway.networking.k8s.io/my-namespace/my-gateway": nil,
+}
+
+var IstioVirtualServicesTestValues = map[string]*ResourceUri{
+	"istio:virtualservices.v1.networking.istio.io/my-namespace/my-virtual-service": NewResourceUriBuilder(IstioScheme).
+		Kind("virtualservices").
+		Group("networking.istio.io").
+		Version("v1").
+		Namespace("my-namespace").
+		Name("my-virtual-service").
+		Build(),
+
+	"istio:virtualservices.v1beta1.networking.istio.io/my-virtual-service": NewResourceUriBuilder(IstioScheme).
+		Kind("virtualservices").
+		Group("networking.istio.io").
+		Version("v1beta1").
+		Name("my-virtual-service").
+		Build(),
+}
+
+var IstioServiceEntriesTestValues = map[string]*ResourceUri{
+	"istio:serviceentries.v1.networking.istio.io": nil,
+
+	"istio:serviceentries.v1beta1.networking.istio.io/my-namespace/my-service-entry?port=http": NewResourceUriBuilder(IstioScheme).
+		Kind("serviceentries").
+		Group("networking.istio.io").
+		Version("v1beta1").
+		Namespace("my-namespace").
+		Name("my-service-entry").
+		WithPort("http").
+		Build(),
+}
+
+var ConfigConfigMapsTestValues = map[string]*ResourceUri{
+	"config:configmaps.v1/shared/endpoints": nil,
+
+	"config:configmaps.v1/shared/endpoints?key=": nil,
+
+	"config:configmaps.v1/shared/endpoints?key=billing": NewResourceUriBuilder(ConfigScheme).
+		Kind("configmaps").
+		Version("v1").
+		Namespace("shared").
+		Name("endpoints").
+		WithQueryParam("key", "billing").
+		Build(),
+
+	"config:configmaps.v1/endpoints?key=billing.url": NewResourceUriBuilder(ConfigScheme).
+		Kind("configmaps").
+		Version("v1").
+		Name("endpoints").
+		WithQueryParam("key", "billing.url").
+		Build(),
+}
+
+var ConfigSecretsTestValues = map[string]*ResourceUri{
+	"config:secrets.v1/shared/endpoints?label-a=value-a": nil,
+
+	"config:secrets.v1/shared/endpoints?key=billing": NewResourceUriBuilder(ConfigScheme).
+		Kind("secrets").
+		Version("v1").
+		Namespace("shared").
+		Name("endpoints").
+		WithQueryParam("key", "billing").
+		Build(),
+}
+
 func TestParseKubernetesServicesURI(t *testing.T) {
 	for k, v := range KubernetesServicesTestValues {
 		doTestParseURI(t, k, v)
@@ -300,6 +395,42 @@ func TestParseOpenshiftDeploymentConfigsURI(t *testing.T) {
 	}
 }
 
+func TestParseGatewayHTTPRoutesURI(t *testing.T) {
+	for k, v := range GatewayHTTPRoutesTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
+func TestParseGatewayGatewaysURI(t *testing.T) {
+	for k, v := range GatewayGatewaysTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
+func TestParseIstioVirtualServicesURI(t *testing.T) {
+	for k, v := range IstioVirtualServicesTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
+func TestParseIstioServiceEntriesURI(t *testing.T) {
+	for k, v := range IstioServiceEntriesTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
+func TestParseConfigConfigMapsURI(t *testing.T) {
+	for k, v := range ConfigConfigMapsTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
+func TestParseConfigSecretsURI(t *testing.T) {
+	for k, v := range ConfigSecretsTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
 func doTestParseURI(t *testing.T, url string, expectedUri *ResourceUri) {
 	result, err := ParseUri(url)
 	if expectedUri == nil {